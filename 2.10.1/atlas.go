@@ -0,0 +1,565 @@
+package freetype2
+
+import (
+	"image"
+	"image/draw"
+)
+
+// AtlasFormat is the pixel format of the pages of an Atlas.
+type AtlasFormat int
+
+const (
+	// AtlasAlpha pages are *image.Alpha and hold coverage values. Color
+	// glyphs are stored as their alpha channel.
+	AtlasAlpha AtlasFormat = iota
+	// AtlasRGBA pages are *image.RGBA and hold premultiplied colors. Coverage
+	// glyphs are stored as white, so that they can be tinted when sampled.
+	AtlasRGBA
+)
+
+func (f AtlasFormat) String() string {
+	switch f {
+	case AtlasAlpha:
+		return "Alpha"
+	case AtlasRGBA:
+		return "RGBA"
+	default:
+		return "Unknown"
+	}
+}
+
+// AtlasPacker is the algorithm used to place glyphs in the pages of an Atlas.
+type AtlasPacker int
+
+const (
+	// AtlasSkyline places every glyph as low as possible on the skyline
+	// formed by the glyphs already in the page. It wastes little space when
+	// glyph sizes vary.
+	AtlasSkyline AtlasPacker = iota
+	// AtlasShelf places glyphs left to right in rows as tall as the first
+	// glyph placed on them. It is faster, and wastes little space when glyph
+	// sizes are similar, such as text of a single size.
+	AtlasShelf
+)
+
+func (p AtlasPacker) String() string {
+	switch p {
+	case AtlasSkyline:
+		return "Skyline"
+	case AtlasShelf:
+		return "Shelf"
+	default:
+		return "Unknown"
+	}
+}
+
+// AtlasOptions configures an Atlas.
+type AtlasOptions struct {
+	// The pixel format of the pages.
+	Format AtlasFormat
+	// The packing algorithm.
+	Packer AtlasPacker
+	// The size of the pages in pixels, 1024 when zero.
+	PageWidth, PageHeight int
+	// The number of empty pixels kept around every glyph, so that sampling
+	// with linear filtering does not bleed into neighbouring glyphs.
+	Padding int
+	// The maximum number of pages, unlimited when zero. When every page is
+	// full, the least recently used one is cleared to make room.
+	MaxPages int
+}
+
+// AtlasGlyph is the location of a glyph in an Atlas, along with the metrics
+// needed to draw it.
+type AtlasGlyph struct {
+	// The index of the page holding the glyph, or -1 for glyphs with an empty
+	// bitmap, like spaces.
+	Page int
+	// The glyph's bitmap in the page, in pixels, excluding the padding.
+	Rect image.Rectangle
+	// The texture coordinates of Rect, normalized to the page size.
+	U0, V0, U1, V1 float32
+	// The bitmap's left bearing expressed in integer pixels.
+	BitmapLeft int
+	// The bitmap's top bearing expressed in integer pixels, upwards y
+	// coordinates being positive.
+	BitmapTop int
+	// The glyph's advance, in 26.6 pixels.
+	Advance Vector26_6
+}
+
+// Atlas packs rendered glyphs into fixed size texture pages, as needed by
+// GPU text renderers.
+//
+// Glyphs are identified by a key chosen by the caller, which must be
+// comparable, typically a GlyphIndex or a struct also holding the face and
+// size. New pages are added as existing ones fill up, and once MaxPages is
+// reached the least recently used page is cleared, evicting all of its glyphs.
+// AtlasGlyph values of evicted glyphs are stale, so glyphs should be looked up
+// again every time they are drawn.
+//
+// Pages are modified in place, see Dirty to find the regions that need to be
+// uploaded again. An Atlas is not safe for concurrent use.
+type Atlas struct {
+	opts    AtlasOptions
+	pages   []*atlasPage
+	entries map[interface{}]*atlasEntry
+	clock   uint64
+}
+
+type atlasEntry struct {
+	key   interface{}
+	glyph AtlasGlyph
+}
+
+type atlasPage struct {
+	img     draw.Image
+	packer  atlasAllocator
+	entries map[*atlasEntry]struct{}
+	dirty   image.Rectangle
+	lastUse uint64
+}
+
+// atlasAllocator finds room for rectangles in a page.
+type atlasAllocator interface {
+	alloc(w, h int) (image.Point, bool)
+	reset()
+}
+
+// NewAtlas creates an empty atlas. It returns ErrInvalidArgument if opts holds
+// negative sizes, or an unknown format or packer.
+func NewAtlas(opts AtlasOptions) (*Atlas, error) {
+	if opts.PageWidth == 0 {
+		opts.PageWidth = 1024
+	}
+	if opts.PageHeight == 0 {
+		opts.PageHeight = 1024
+	}
+	if opts.PageWidth < 0 || opts.PageHeight < 0 || opts.Padding < 0 || opts.MaxPages < 0 {
+		return nil, ErrInvalidArgument
+	}
+	if opts.Format != AtlasAlpha && opts.Format != AtlasRGBA {
+		return nil, ErrInvalidArgument
+	}
+	if opts.Packer != AtlasSkyline && opts.Packer != AtlasShelf {
+		return nil, ErrInvalidArgument
+	}
+
+	return &Atlas{
+		opts:    opts,
+		entries: make(map[interface{}]*atlasEntry),
+	}, nil
+}
+
+// Options returns the options of the atlas, with defaults filled in.
+func (a *Atlas) Options() AtlasOptions {
+	if a == nil {
+		return AtlasOptions{}
+	}
+	return a.opts
+}
+
+// Add packs the glyph into the atlas under key. If key is already present the
+// existing glyph is returned, and g is ignored.
+//
+// It returns ErrInvalidArgument if the glyph, with its padding, does not fit
+// in a page, and ErrInvalidGlyphFormat for LCD bitmaps.
+func (a *Atlas) Add(key interface{}, g CachedGlyph) (AtlasGlyph, error) {
+	return a.add(key, &g.bitmap, AtlasGlyph{
+		BitmapLeft: g.BitmapLeft,
+		BitmapTop:  g.BitmapTop,
+		Advance:    g.Advance,
+	})
+}
+
+// AddGlyphSlot is like Add, but takes the glyph rendered in slot, see
+// GlyphSlot.RenderGlyph.
+func (a *Atlas) AddGlyphSlot(key interface{}, slot *GlyphSlot) (AtlasGlyph, error) {
+	if slot == nil {
+		return AtlasGlyph{}, ErrInvalidArgument
+	}
+	if slot.Format != GlyphFormatBitmap {
+		return AtlasGlyph{}, ErrInvalidGlyphFormat
+	}
+
+	return a.add(key, slot.Bitmap, AtlasGlyph{
+		BitmapLeft: slot.BitmapLeft,
+		BitmapTop:  slot.BitmapTop,
+		Advance:    slot.Advance,
+	})
+}
+
+func (a *Atlas) add(key interface{}, b *Bitmap, glyph AtlasGlyph) (AtlasGlyph, error) {
+	if a == nil {
+		return AtlasGlyph{}, ErrInvalidArgument
+	}
+
+	if g, ok := a.Glyph(key); ok {
+		return g, nil
+	}
+
+	if b != nil && (b.PixelMode == PixelModeLCD || b.PixelMode == PixelModeLCDV) {
+		return AtlasGlyph{}, ErrInvalidGlyphFormat
+	}
+
+	entry := &atlasEntry{key: key, glyph: glyph}
+	entry.glyph.Page = -1
+	if b == nil || b.Width == 0 || b.Rows == 0 || len(b.Buffer) == 0 {
+		a.entries[key] = entry
+		return entry.glyph, nil
+	}
+
+	bounds := b.Bounds()
+	w, h := bounds.Dx()+2*a.opts.Padding, bounds.Dy()+2*a.opts.Padding
+	if w > a.opts.PageWidth || h > a.opts.PageHeight {
+		return AtlasGlyph{}, ErrInvalidArgument
+	}
+
+	idx, at := a.alloc(w, h)
+	page := a.pages[idx]
+	r := image.Rectangle{Min: at, Max: at.Add(image.Pt(w, h))}.Inset(a.opts.Padding)
+	copyAtlasBitmap(page.img, r, b)
+
+	entry.glyph.Page = idx
+	entry.glyph.Rect = r
+	entry.glyph.U0 = float32(r.Min.X) / float32(a.opts.PageWidth)
+	entry.glyph.V0 = float32(r.Min.Y) / float32(a.opts.PageHeight)
+	entry.glyph.U1 = float32(r.Max.X) / float32(a.opts.PageWidth)
+	entry.glyph.V1 = float32(r.Max.Y) / float32(a.opts.PageHeight)
+
+	a.entries[key] = entry
+	page.entries[entry] = struct{}{}
+	page.dirty = page.dirty.Union(r)
+	a.touch(page)
+
+	return entry.glyph, nil
+}
+
+// alloc finds room for a w by h rectangle, adding or clearing a page if
+// needed. The rectangle must fit in an empty page.
+func (a *Atlas) alloc(w, h int) (int, image.Point) {
+	for i, p := range a.pages {
+		if at, ok := p.packer.alloc(w, h); ok {
+			return i, at
+		}
+	}
+
+	if a.opts.MaxPages == 0 || len(a.pages) < a.opts.MaxPages {
+		a.pages = append(a.pages, a.newPage())
+		at, _ := a.pages[len(a.pages)-1].packer.alloc(w, h)
+		return len(a.pages) - 1, at
+	}
+
+	lru := 0
+	for i, p := range a.pages {
+		if p.lastUse < a.pages[lru].lastUse {
+			lru = i
+		}
+	}
+	a.clearPage(lru)
+	at, _ := a.pages[lru].packer.alloc(w, h)
+	return lru, at
+}
+
+func (a *Atlas) newPage() *atlasPage {
+	r := image.Rect(0, 0, a.opts.PageWidth, a.opts.PageHeight)
+
+	var img draw.Image
+	switch a.opts.Format {
+	case AtlasRGBA:
+		img = image.NewRGBA(r)
+	default:
+		img = image.NewAlpha(r)
+	}
+
+	var packer atlasAllocator
+	switch a.opts.Packer {
+	case AtlasShelf:
+		packer = &shelfAllocator{width: a.opts.PageWidth, height: a.opts.PageHeight}
+	default:
+		packer = &skylineAllocator{width: a.opts.PageWidth, height: a.opts.PageHeight}
+	}
+	packer.reset()
+
+	return &atlasPage{
+		img:     img,
+		packer:  packer,
+		entries: make(map[*atlasEntry]struct{}),
+	}
+}
+
+func (a *Atlas) clearPage(i int) {
+	p := a.pages[i]
+	for e := range p.entries {
+		delete(a.entries, e.key)
+	}
+	p.entries = make(map[*atlasEntry]struct{})
+	p.packer.reset()
+
+	switch img := p.img.(type) {
+	case *image.Alpha:
+		for j := range img.Pix {
+			img.Pix[j] = 0
+		}
+	case *image.RGBA:
+		for j := range img.Pix {
+			img.Pix[j] = 0
+		}
+	}
+	p.dirty = p.img.Bounds()
+}
+
+func (a *Atlas) touch(p *atlasPage) {
+	a.clock++
+	p.lastUse = a.clock
+}
+
+// Glyph returns the glyph stored under key, and marks its page as used.
+func (a *Atlas) Glyph(key interface{}) (AtlasGlyph, bool) {
+	if a == nil {
+		return AtlasGlyph{}, false
+	}
+
+	e, ok := a.entries[key]
+	if !ok {
+		return AtlasGlyph{}, false
+	}
+	if e.glyph.Page >= 0 {
+		a.touch(a.pages[e.glyph.Page])
+	}
+	return e.glyph, true
+}
+
+// Remove removes the glyph stored under key. The space it used is only
+// reclaimed when its page is cleared.
+func (a *Atlas) Remove(key interface{}) {
+	if a == nil {
+		return
+	}
+
+	e, ok := a.entries[key]
+	if !ok {
+		return
+	}
+	delete(a.entries, key)
+	if e.glyph.Page >= 0 {
+		delete(a.pages[e.glyph.Page].entries, e)
+	}
+}
+
+// Reset removes every glyph from the atlas and clears its pages, which are
+// kept for reuse.
+func (a *Atlas) Reset() {
+	if a == nil {
+		return
+	}
+
+	for i := range a.pages {
+		a.clearPage(i)
+	}
+	a.entries = make(map[interface{}]*atlasEntry)
+}
+
+// Len returns the number of glyphs in the atlas.
+func (a *Atlas) Len() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.entries)
+}
+
+// NumPages returns the number of pages of the atlas.
+func (a *Atlas) NumPages() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.pages)
+}
+
+// Page returns the i-th page, an *image.Alpha or an *image.RGBA depending on
+// the atlas format, or nil if i is out of range. The image is modified as
+// glyphs are added.
+func (a *Atlas) Page(i int) image.Image {
+	if a == nil || i < 0 || i >= len(a.pages) {
+		return nil
+	}
+	return a.pages[i].img
+}
+
+// Dirty returns the region of the i-th page modified since the last call, and
+// resets it. It is empty when the page does not need to be uploaded again.
+func (a *Atlas) Dirty(i int) image.Rectangle {
+	if a == nil || i < 0 || i >= len(a.pages) {
+		return image.Rectangle{}
+	}
+
+	r := a.pages[i].dirty
+	a.pages[i].dirty = image.Rectangle{}
+	return r
+}
+
+// copyAtlasBitmap copies b into r of dst, which is as big as b.
+func copyAtlasBitmap(dst draw.Image, r image.Rectangle, b *Bitmap) {
+	switch dst := dst.(type) {
+	case *image.Alpha:
+		if b.PixelMode == PixelModeGray && b.NumGrays == 256 {
+			for y := 0; y < r.Dy(); y++ {
+				src := b.Buffer[b.rowOffset(y):]
+				copy(dst.Pix[dst.PixOffset(r.Min.X, r.Min.Y+y):], src[:r.Dx()])
+			}
+			return
+		}
+	case *image.RGBA:
+		if b.PixelMode == PixelModeBGRA {
+			for y := 0; y < r.Dy(); y++ {
+				src := b.Buffer[b.rowOffset(y):]
+				pix := dst.Pix[dst.PixOffset(r.Min.X, r.Min.Y+y):]
+				for x := 0; x < r.Dx(); x++ {
+					pix[4*x+0] = src[4*x+2]
+					pix[4*x+1] = src[4*x+1]
+					pix[4*x+2] = src[4*x+0]
+					pix[4*x+3] = src[4*x+3]
+				}
+			}
+			return
+		}
+	}
+
+	draw.Draw(dst, r, b, image.Point{}, draw.Src)
+}
+
+// skylineAllocator implements the bottom-left skyline packing algorithm.
+type skylineAllocator struct {
+	width, height int
+	// the top edge of the allocated area, from left to right.
+	nodes []skylineNode
+}
+
+type skylineNode struct {
+	x, y, width int
+}
+
+func (s *skylineAllocator) reset() {
+	s.nodes = append(s.nodes[:0], skylineNode{width: s.width})
+}
+
+func (s *skylineAllocator) alloc(w, h int) (image.Point, bool) {
+	best, bestY, bestWidth := -1, 0, 0
+	for i, n := range s.nodes {
+		y, ok := s.fit(i, w, h)
+		if !ok {
+			continue
+		}
+		if best < 0 || y+h < bestY+h || (y == bestY && n.width < bestWidth) {
+			best, bestY, bestWidth = i, y, n.width
+		}
+	}
+	if best < 0 {
+		return image.Point{}, false
+	}
+
+	at := image.Pt(s.nodes[best].x, bestY)
+	s.nodes = append(s.nodes, skylineNode{})
+	copy(s.nodes[best+1:], s.nodes[best:])
+	s.nodes[best] = skylineNode{x: at.X, y: bestY + h, width: w}
+
+	// shrink or remove the nodes now covered by the new one.
+	for i := best + 1; i < len(s.nodes); {
+		prev, n := s.nodes[i-1], &s.nodes[i]
+		end := prev.x + prev.width
+		if n.x >= end {
+			break
+		}
+		shrink := end - n.x
+		if n.width <= shrink {
+			s.nodes = append(s.nodes[:i], s.nodes[i+1:]...)
+			continue
+		}
+		n.x += shrink
+		n.width -= shrink
+		break
+	}
+
+	// merge neighbours of the same height.
+	for i := 0; i < len(s.nodes)-1; {
+		if s.nodes[i].y == s.nodes[i+1].y {
+			s.nodes[i].width += s.nodes[i+1].width
+			s.nodes = append(s.nodes[:i+1], s.nodes[i+2:]...)
+			continue
+		}
+		i++
+	}
+
+	return at, true
+}
+
+// fit returns the y coordinate of a w by h rectangle placed at the left edge
+// of the i-th node.
+func (s *skylineAllocator) fit(i, w, h int) (int, bool) {
+	x := s.nodes[i].x
+	if x+w > s.width {
+		return 0, false
+	}
+
+	y := 0
+	for left := w; left > 0; i++ {
+		if s.nodes[i].y > y {
+			y = s.nodes[i].y
+		}
+		if y+h > s.height {
+			return 0, false
+		}
+		left -= s.nodes[i].width
+	}
+	return y, true
+}
+
+// shelfAllocator places rectangles in rows, choosing the row that wastes the
+// least height.
+type shelfAllocator struct {
+	width, height int
+	shelves       []shelf
+}
+
+type shelf struct {
+	y, height int
+	// the used width.
+	x int
+}
+
+func (s *shelfAllocator) reset() {
+	s.shelves = s.shelves[:0]
+}
+
+func (s *shelfAllocator) alloc(w, h int) (image.Point, bool) {
+	if w > s.width {
+		return image.Point{}, false
+	}
+
+	best := -1
+	for i, sh := range s.shelves {
+		if sh.height < h || sh.x+w > s.width {
+			continue
+		}
+		if best < 0 || sh.height < s.shelves[best].height {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		y := 0
+		if n := len(s.shelves); n > 0 {
+			y = s.shelves[n-1].y + s.shelves[n-1].height
+		}
+		if y+h > s.height {
+			return image.Point{}, false
+		}
+		s.shelves = append(s.shelves, shelf{y: y, height: h})
+		best = len(s.shelves) - 1
+	}
+
+	sh := &s.shelves[best]
+	at := image.Pt(sh.x, sh.y)
+	sh.x += w
+	return at, true
+}
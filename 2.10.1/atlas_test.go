@@ -0,0 +1,405 @@
+package freetype2
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/flga/freetype2/fixed"
+)
+
+func TestAtlasFormat_String(t *testing.T) {
+	tests := []struct {
+		name string
+		f    AtlasFormat
+		want string
+	}{
+		{name: "AtlasAlpha", f: AtlasAlpha, want: "Alpha"},
+		{name: "AtlasRGBA", f: AtlasRGBA, want: "RGBA"},
+		{name: "unknown", f: AtlasFormat(-1), want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.String(); got != tt.want {
+				t.Errorf("AtlasFormat.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAtlasPacker_String(t *testing.T) {
+	tests := []struct {
+		name string
+		p    AtlasPacker
+		want string
+	}{
+		{name: "AtlasSkyline", p: AtlasSkyline, want: "Skyline"},
+		{name: "AtlasShelf", p: AtlasShelf, want: "Shelf"},
+		{name: "unknown", p: AtlasPacker(-1), want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.String(); got != tt.want {
+				t.Errorf("AtlasPacker.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAtlas(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    AtlasOptions
+		want    AtlasOptions
+		wantErr error
+	}{
+		{name: "defaults", opts: AtlasOptions{}, want: AtlasOptions{PageWidth: 1024, PageHeight: 1024}},
+		{
+			name: "custom",
+			opts: AtlasOptions{Format: AtlasRGBA, Packer: AtlasShelf, PageWidth: 256, PageHeight: 128, Padding: 1, MaxPages: 2},
+			want: AtlasOptions{Format: AtlasRGBA, Packer: AtlasShelf, PageWidth: 256, PageHeight: 128, Padding: 1, MaxPages: 2},
+		},
+		{name: "negative size", opts: AtlasOptions{PageWidth: -1}, wantErr: ErrInvalidArgument},
+		{name: "negative padding", opts: AtlasOptions{Padding: -1}, wantErr: ErrInvalidArgument},
+		{name: "negative max pages", opts: AtlasOptions{MaxPages: -1}, wantErr: ErrInvalidArgument},
+		{name: "bad format", opts: AtlasOptions{Format: AtlasFormat(-1)}, wantErr: ErrInvalidArgument},
+		{name: "bad packer", opts: AtlasOptions{Packer: AtlasPacker(-1)}, wantErr: ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAtlas(tt.opts)
+			if err != tt.wantErr {
+				t.Fatalf("NewAtlas() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Options() != tt.want {
+				t.Errorf("NewAtlas() options = %v, want %v", got.Options(), tt.want)
+			}
+			if got.Len() != 0 || got.NumPages() != 0 {
+				t.Errorf("NewAtlas() Len() = %v, NumPages() = %v, want 0", got.Len(), got.NumPages())
+			}
+		})
+	}
+}
+
+// atlasGlyph returns a glyph with a w by h gray bitmap filled with v.
+func atlasGlyph(w, h int, v byte) CachedGlyph {
+	buf := make([]byte, w*h)
+	for i := range buf {
+		buf[i] = v
+	}
+	return CachedGlyph{
+		bitmap: Bitmap{
+			Rows:      h,
+			Width:     w,
+			Pitch:     w,
+			Buffer:    buf,
+			NumGrays:  256,
+			PixelMode: PixelModeGray,
+		},
+		BitmapLeft: 1,
+		BitmapTop:  h,
+		Advance:    Vector26_6{X: fixed.Int26_6(w+2) << 6},
+	}
+}
+
+func TestAtlas_Add(t *testing.T) {
+	var nilAtlas *Atlas
+	if _, err := nilAtlas.Add(0, atlasGlyph(1, 1, 0xff)); err != ErrInvalidArgument {
+		t.Errorf("Atlas.Add() error = %v, want %v", err, ErrInvalidArgument)
+	}
+
+	a, err := NewAtlas(AtlasOptions{PageWidth: 16, PageHeight: 8, Padding: 1})
+	if err != nil {
+		t.Fatalf("unable to create atlas: %v", err)
+	}
+
+	got, err := a.Add("a", atlasGlyph(3, 2, 0x80))
+	if err != nil {
+		t.Fatalf("Atlas.Add() error = %v", err)
+	}
+	want := AtlasGlyph{
+		Page:       0,
+		Rect:       image.Rect(1, 1, 4, 3),
+		U0:         1.0 / 16,
+		V0:         1.0 / 8,
+		U1:         4.0 / 16,
+		V1:         3.0 / 8,
+		BitmapLeft: 1,
+		BitmapTop:  2,
+		Advance:    Vector26_6{X: 5 << 6},
+	}
+	if diff := diff(got, want); diff != nil {
+		t.Errorf("Atlas.Add() = %v", diff)
+	}
+
+	page, ok := a.Page(0).(*image.Alpha)
+	if !ok {
+		t.Fatalf("Atlas.Page() = %T, want *image.Alpha", a.Page(0))
+	}
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 16; x++ {
+			want := color.Alpha{}
+			if image.Pt(x, y).In(got.Rect) {
+				want = color.Alpha{A: 0x80}
+			}
+			if got := page.AlphaAt(x, y); got != want {
+				t.Errorf("Atlas.Page().AlphaAt(%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+
+	if got := a.Dirty(0); got != want.Rect {
+		t.Errorf("Atlas.Dirty() = %v, want %v", got, want.Rect)
+	}
+	if got := a.Dirty(0); !got.Empty() {
+		t.Errorf("Atlas.Dirty() = %v, want empty", got)
+	}
+
+	// adding the same key returns the existing glyph.
+	if again, err := a.Add("a", atlasGlyph(5, 5, 0xff)); err != nil || again != got || a.Len() != 1 {
+		t.Errorf("Atlas.Add() = %v, %v, Len() = %v, want the existing glyph", again, err, a.Len())
+	}
+
+	// the padding is kept between glyphs.
+	next, err := a.Add("b", atlasGlyph(2, 2, 0xff))
+	if err != nil {
+		t.Fatalf("Atlas.Add() error = %v", err)
+	}
+	if next.Rect.Inset(-1).Overlaps(got.Rect.Inset(-1)) {
+		t.Errorf("Atlas.Add() = %v, overlaps the padding of %v", next.Rect, got.Rect)
+	}
+
+	space, err := a.Add("space", CachedGlyph{Advance: Vector26_6{X: 4 << 6}})
+	if err != nil {
+		t.Fatalf("Atlas.Add() error = %v", err)
+	}
+	if diff := diff(space, AtlasGlyph{Page: -1, Advance: Vector26_6{X: 4 << 6}}); diff != nil {
+		t.Errorf("Atlas.Add() = %v", diff)
+	}
+
+	if _, err := a.Add("big", atlasGlyph(15, 1, 0xff)); err != ErrInvalidArgument {
+		t.Errorf("Atlas.Add() error = %v, want %v", err, ErrInvalidArgument)
+	}
+
+	lcd := atlasGlyph(3, 1, 0xff)
+	lcd.bitmap.PixelMode = PixelModeLCD
+	if _, err := a.Add("lcd", lcd); err != ErrInvalidGlyphFormat {
+		t.Errorf("Atlas.Add() error = %v, want %v", err, ErrInvalidGlyphFormat)
+	}
+
+	if a.Len() != 3 || a.NumPages() != 1 {
+		t.Errorf("Atlas.Len() = %v, NumPages() = %v, want 3, 1", a.Len(), a.NumPages())
+	}
+}
+
+func TestAtlas_Add_formats(t *testing.T) {
+	gray := atlasGlyph(2, 1, 0x80)
+
+	mono := atlasGlyph(2, 1, 0)
+	mono.bitmap.Pitch = 1
+	mono.bitmap.Buffer = []byte{0x80}
+	mono.bitmap.NumGrays = 2
+	mono.bitmap.PixelMode = PixelModeMono
+
+	bgra := atlasGlyph(2, 1, 0)
+	bgra.bitmap.Pitch = 8
+	bgra.bitmap.Buffer = []byte{0x10, 0x20, 0x30, 0x40, 0x00, 0x00, 0x80, 0x80}
+	bgra.bitmap.PixelMode = PixelModeBGRA
+
+	tests := []struct {
+		name   string
+		format AtlasFormat
+		glyph  CachedGlyph
+		want   []color.Color
+	}{
+		{name: "gray to alpha", format: AtlasAlpha, glyph: gray, want: []color.Color{color.Alpha{A: 0x80}, color.Alpha{A: 0x80}}},
+		{name: "mono to alpha", format: AtlasAlpha, glyph: mono, want: []color.Color{color.Alpha{A: 0xff}, color.Alpha{}}},
+		{name: "bgra to alpha", format: AtlasAlpha, glyph: bgra, want: []color.Color{color.Alpha{A: 0x40}, color.Alpha{A: 0x80}}},
+		{
+			name: "gray to rgba", format: AtlasRGBA, glyph: gray,
+			want: []color.Color{color.RGBA{R: 0x80, G: 0x80, B: 0x80, A: 0x80}, color.RGBA{R: 0x80, G: 0x80, B: 0x80, A: 0x80}},
+		},
+		{
+			name: "bgra to rgba", format: AtlasRGBA, glyph: bgra,
+			want: []color.Color{color.RGBA{R: 0x30, G: 0x20, B: 0x10, A: 0x40}, color.RGBA{R: 0x80, A: 0x80}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAtlas(AtlasOptions{Format: tt.format, PageWidth: 4, PageHeight: 4})
+			if err != nil {
+				t.Fatalf("unable to create atlas: %v", err)
+			}
+
+			g, err := a.Add(0, tt.glyph)
+			if err != nil {
+				t.Fatalf("Atlas.Add() error = %v", err)
+			}
+
+			page := a.Page(g.Page)
+			var got []color.Color
+			for x := g.Rect.Min.X; x < g.Rect.Max.X; x++ {
+				got = append(got, page.At(x, g.Rect.Min.Y))
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Atlas.Add() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestAtlas_packers(t *testing.T) {
+	for _, packer := range []AtlasPacker{AtlasSkyline, AtlasShelf} {
+		t.Run(packer.String(), func(t *testing.T) {
+			a, err := NewAtlas(AtlasOptions{Packer: packer, PageWidth: 64, PageHeight: 64, Padding: 1})
+			if err != nil {
+				t.Fatalf("unable to create atlas: %v", err)
+			}
+
+			var placed []AtlasGlyph
+			for i := 0; i < 60; i++ {
+				g, err := a.Add(i, atlasGlyph(1+i%7, 1+(i*3)%9, 0xff))
+				if err != nil {
+					t.Fatalf("Atlas.Add() error = %v", err)
+				}
+				placed = append(placed, g)
+			}
+
+			bounds := image.Rect(0, 0, 64, 64)
+			for i, g := range placed {
+				if !g.Rect.Inset(-1).In(bounds) {
+					t.Errorf("glyph %d at %v is out of the page", i, g.Rect)
+				}
+				for j := 0; j < i; j++ {
+					if g.Page == placed[j].Page && g.Rect.Inset(-1).Overlaps(placed[j].Rect.Inset(-1)) {
+						t.Errorf("glyph %d at %v overlaps glyph %d at %v", i, g.Rect, j, placed[j].Rect)
+					}
+				}
+				if got, ok := a.Glyph(i); !ok || got != g {
+					t.Errorf("Atlas.Glyph(%d) = %v, %v, want %v", i, got, ok, g)
+				}
+			}
+		})
+	}
+}
+
+func TestAtlas_growth(t *testing.T) {
+	a, err := NewAtlas(AtlasOptions{PageWidth: 8, PageHeight: 8, MaxPages: 2})
+	if err != nil {
+		t.Fatalf("unable to create atlas: %v", err)
+	}
+
+	add := func(key int) AtlasGlyph {
+		g, err := a.Add(key, atlasGlyph(8, 8, byte(key)))
+		if err != nil {
+			t.Fatalf("Atlas.Add() error = %v", err)
+		}
+		return g
+	}
+
+	// every glyph fills a page.
+	if g := add(1); g.Page != 0 {
+		t.Errorf("Atlas.Add() page = %v, want 0", g.Page)
+	}
+	if g := add(2); g.Page != 1 {
+		t.Errorf("Atlas.Add() page = %v, want 1", g.Page)
+	}
+	if a.NumPages() != 2 {
+		t.Errorf("Atlas.NumPages() = %v, want 2", a.NumPages())
+	}
+	a.Dirty(0)
+	a.Dirty(1)
+
+	// 1 is used again, so the page of 2 is evicted.
+	if _, ok := a.Glyph(1); !ok {
+		t.Errorf("Atlas.Glyph() missing glyph")
+	}
+	if g := add(3); g.Page != 1 {
+		t.Errorf("Atlas.Add() page = %v, want 1", g.Page)
+	}
+	if _, ok := a.Glyph(2); ok {
+		t.Errorf("Atlas.Glyph() evicted glyph still present")
+	}
+	if a.NumPages() != 2 || a.Len() != 2 {
+		t.Errorf("Atlas.NumPages() = %v, Len() = %v, want 2, 2", a.NumPages(), a.Len())
+	}
+	if got := a.Page(1).(*image.Alpha).AlphaAt(0, 0); got.A != 3 {
+		t.Errorf("Atlas.Page() = %v, want the new glyph", got)
+	}
+	if got := a.Dirty(1); got != image.Rect(0, 0, 8, 8) {
+		t.Errorf("Atlas.Dirty() = %v, want the whole page", got)
+	}
+
+	a.Remove(1)
+	if _, ok := a.Glyph(1); ok || a.Len() != 1 {
+		t.Errorf("Atlas.Remove() did not remove the glyph")
+	}
+
+	a.Reset()
+	if a.Len() != 0 || a.NumPages() != 2 {
+		t.Errorf("Atlas.Reset() Len() = %v, NumPages() = %v, want 0, 2", a.Len(), a.NumPages())
+	}
+	if got := a.Page(1).(*image.Alpha).AlphaAt(0, 0); got.A != 0 {
+		t.Errorf("Atlas.Reset() did not clear the pages")
+	}
+	if a.Page(2) != nil {
+		t.Errorf("Atlas.Page() out of range is not nil")
+	}
+}
+
+func TestAtlas_AddGlyphSlot(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetPixelSizes(0, 16); err != nil {
+		t.Fatalf("unable to set size: %v", err)
+	}
+
+	a, err := NewAtlas(AtlasOptions{PageWidth: 64, PageHeight: 64})
+	if err != nil {
+		t.Fatalf("unable to create atlas: %v", err)
+	}
+
+	if _, err := a.AddGlyphSlot(0, nil); err != ErrInvalidArgument {
+		t.Errorf("Atlas.AddGlyphSlot() error = %v, want %v", err, ErrInvalidArgument)
+	}
+
+	idx := face.CharIndex('A')
+	if err := face.LoadGlyph(idx, LoadNoBitmap); err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+	if _, err := a.AddGlyphSlot(idx, face.GlyphSlot()); err != ErrInvalidGlyphFormat {
+		t.Errorf("Atlas.AddGlyphSlot() error = %v, want %v", err, ErrInvalidGlyphFormat)
+	}
+
+	if err := face.LoadGlyph(idx, LoadRender); err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+	slot := face.GlyphSlot()
+	got, err := a.AddGlyphSlot(idx, slot)
+	if err != nil {
+		t.Fatalf("Atlas.AddGlyphSlot() error = %v", err)
+	}
+
+	if got.BitmapLeft != slot.BitmapLeft || got.BitmapTop != slot.BitmapTop || got.Advance != slot.Advance {
+		t.Errorf("Atlas.AddGlyphSlot() metrics = %v", got)
+	}
+	if got.Rect.Size() != slot.Bitmap.Bounds().Size() {
+		t.Errorf("Atlas.AddGlyphSlot() rect = %v, want the size of %v", got.Rect, slot.Bitmap.Bounds())
+	}
+
+	page := a.Page(got.Page)
+	for y := 0; y < slot.Bitmap.Rows; y++ {
+		for x := 0; x < slot.Bitmap.Width; x++ {
+			if got, want := page.At(got.Rect.Min.X+x, got.Rect.Min.Y+y), slot.Bitmap.At(x, y); got != want {
+				t.Fatalf("Atlas.Page().At(%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package freetype2
+
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include FT_BITMAP_H
+import "C"
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"unsafe"
+)
+
+var _ draw.Image = &Bitmap{}
+
+// ColorModel returns the color model of the bitmap's pixels, which depends on
+// its PixelMode.
+//
+// PixelModeMono, PixelModeGray, PixelModeGray2 and PixelModeGray4 hold
+// coverage values, and use color.AlphaModel. PixelModeBGRA holds premultiplied
+// colors and PixelModeLCD and PixelModeLCDV hold one coverage value per
+// subpixel, they all use color.RGBAModel, see LCDCoverage for the latter.
+func (b *Bitmap) ColorModel() color.Model {
+	if b == nil {
+		return color.AlphaModel
+	}
+
+	switch b.PixelMode {
+	case PixelModeLCD, PixelModeLCDV, PixelModeBGRA:
+		return color.RGBAModel
+	default:
+		return color.AlphaModel
+	}
+}
+
+// Bounds returns the domain for which At can return non-zero color, in pixels.
+//
+// The bounds of PixelModeLCD and PixelModeLCDV bitmaps are a third of their
+// Width or Rows, since every pixel is made of three subpixel samples.
+func (b *Bitmap) Bounds() image.Rectangle {
+	if b == nil {
+		return image.Rectangle{}
+	}
+
+	switch b.PixelMode {
+	case PixelModeLCD:
+		return image.Rect(0, 0, b.Width/3, b.Rows)
+	case PixelModeLCDV:
+		return image.Rect(0, 0, b.Width, b.Rows/3)
+	default:
+		return image.Rect(0, 0, b.Width, b.Rows)
+	}
+}
+
+// rowOffset returns the offset in Buffer of the row y, counting from the top,
+// taking the bitmap flow into account.
+func (b *Bitmap) rowOffset(y int) int {
+	if b.Pitch < 0 {
+		return (b.Rows - 1 - y) * -b.Pitch
+	}
+	return y * b.Pitch
+}
+
+// gray scales a PixelModeGray value to 8 bits when NumGrays is not 256.
+func (b *Bitmap) gray(v byte) byte {
+	if b.NumGrays <= 1 || b.NumGrays == 256 {
+		return v
+	}
+	return byte(int(v) * 255 / (b.NumGrays - 1))
+}
+
+// At returns the color of the pixel at (x, y).
+func (b *Bitmap) At(x, y int) color.Color {
+	if b == nil || !image.Pt(x, y).In(b.Bounds()) || b.Buffer == nil {
+		return b.ColorModel().Convert(color.Transparent)
+	}
+
+	row := b.Buffer[b.rowOffset(y):]
+	switch b.PixelMode {
+	case PixelModeMono:
+		if row[x/8]&(0x80>>uint(x%8)) != 0 {
+			return color.Alpha{A: 0xff}
+		}
+		return color.Alpha{}
+	case PixelModeGray2:
+		v := row[x/4] >> (6 - 2*uint(x%4)) & 0x03
+		return color.Alpha{A: v * 0x55}
+	case PixelModeGray4:
+		v := row[x/2] >> (4 - 4*uint(x%2)) & 0x0f
+		return color.Alpha{A: v * 0x11}
+	case PixelModeGray:
+		return color.Alpha{A: b.gray(row[x])}
+	case PixelModeLCD:
+		return lcdColor(row[3*x], row[3*x+1], row[3*x+2])
+	case PixelModeLCDV:
+		return lcdColor(
+			b.Buffer[b.rowOffset(3*y)+x],
+			b.Buffer[b.rowOffset(3*y+1)+x],
+			b.Buffer[b.rowOffset(3*y+2)+x],
+		)
+	case PixelModeBGRA:
+		p := row[4*x : 4*x+4]
+		return color.RGBA{R: p[2], G: p[1], B: p[0], A: p[3]}
+	default:
+		return color.Alpha{}
+	}
+}
+
+func lcdColor(red, green, blue byte) color.RGBA {
+	alpha := red
+	if green > alpha {
+		alpha = green
+	}
+	if blue > alpha {
+		alpha = blue
+	}
+	return color.RGBA{R: red, G: green, B: blue, A: alpha}
+}
+
+// Set sets the color of the pixel at (x, y), converting c to the bitmap's
+// ColorModel. Mono bitmaps set their pixels when c is at least half opaque.
+//
+// Both Buffer and the underlying FreeType bitmap are updated, so the change is
+// visible to functions like Blend or Convert.
+func (b *Bitmap) Set(x, y int, c color.Color) {
+	if b == nil || !image.Pt(x, y).In(b.Bounds()) || b.Buffer == nil {
+		return
+	}
+
+	if b.ColorModel() == color.AlphaModel {
+		a := color.AlphaModel.Convert(c).(color.Alpha).A
+		b.setAlpha(x, y, a)
+		return
+	}
+
+	rgba := color.RGBAModel.Convert(c).(color.RGBA)
+	switch b.PixelMode {
+	case PixelModeLCD:
+		off := b.rowOffset(y) + 3*x
+		b.setByte(off, rgba.R)
+		b.setByte(off+1, rgba.G)
+		b.setByte(off+2, rgba.B)
+	case PixelModeLCDV:
+		b.setByte(b.rowOffset(3*y)+x, rgba.R)
+		b.setByte(b.rowOffset(3*y+1)+x, rgba.G)
+		b.setByte(b.rowOffset(3*y+2)+x, rgba.B)
+	case PixelModeBGRA:
+		off := b.rowOffset(y) + 4*x
+		b.setByte(off, rgba.B)
+		b.setByte(off+1, rgba.G)
+		b.setByte(off+2, rgba.R)
+		b.setByte(off+3, rgba.A)
+	}
+}
+
+func (b *Bitmap) setAlpha(x, y int, a byte) {
+	off := b.rowOffset(y)
+	switch b.PixelMode {
+	case PixelModeMono:
+		off += x / 8
+		mask := byte(0x80 >> uint(x%8))
+		if a >= 0x80 {
+			b.setByte(off, b.Buffer[off]|mask)
+		} else {
+			b.setByte(off, b.Buffer[off]&^mask)
+		}
+	case PixelModeGray2:
+		off += x / 4
+		shift := 6 - 2*uint(x%4)
+		b.setByte(off, b.Buffer[off]&^(0x03<<shift)|(a/0x55)<<shift)
+	case PixelModeGray4:
+		off += x / 2
+		shift := 4 - 4*uint(x%2)
+		b.setByte(off, b.Buffer[off]&^(0x0f<<shift)|(a/0x11)<<shift)
+	case PixelModeGray:
+		if b.NumGrays > 1 && b.NumGrays != 256 {
+			a = byte(int(a) * (b.NumGrays - 1) / 255)
+		}
+		b.setByte(off+x, a)
+	}
+}
+
+// setByte writes v at offset i of both Buffer and the C buffer, if any.
+func (b *Bitmap) setByte(i int, v byte) {
+	b.Buffer[i] = v
+
+	if b.ptr == nil || b.ptr.buffer == nil {
+		return
+	}
+	cbuf := (*[(1<<31 - 1) / C.sizeof_uchar]C.uchar)(unsafe.Pointer(b.ptr.buffer))[:len(b.Buffer):len(b.Buffer)]
+	cbuf[i] = C.uchar(v)
+}
+
+// shareable reports whether Buffer can be used as the pixels of an 8 bit per
+// channel image without conversion.
+func (b *Bitmap) shareable(mode PixelMode) bool {
+	return b.PixelMode == mode && b.Pitch >= 0 && b.Buffer != nil &&
+		(mode != PixelModeGray || b.NumGrays == 256)
+}
+
+// Alpha returns the bitmap as an *image.Alpha, using the coverage of each
+// pixel, see ColorModel.
+//
+// PixelModeGray bitmaps with a down flow are not copied, the returned image
+// shares its pixels with Buffer. Other bitmaps are converted.
+func (b *Bitmap) Alpha() (*image.Alpha, error) {
+	if b == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	if b.shareable(PixelModeGray) {
+		return &image.Alpha{Pix: b.Buffer, Stride: b.Pitch, Rect: b.Bounds()}, nil
+	}
+
+	img := image.NewAlpha(b.Bounds())
+	draw.Draw(img, img.Rect, b, image.Point{}, draw.Src)
+	return img, nil
+}
+
+// Gray returns the bitmap as an *image.Gray, where full coverage is white.
+//
+// PixelModeGray bitmaps with a down flow are not copied, the returned image
+// shares its pixels with Buffer. Other bitmaps are converted.
+func (b *Bitmap) Gray() (*image.Gray, error) {
+	if b == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	if b.shareable(PixelModeGray) {
+		return &image.Gray{Pix: b.Buffer, Stride: b.Pitch, Rect: b.Bounds()}, nil
+	}
+
+	img := image.NewGray(b.Bounds())
+	r := img.Rect
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			_, _, _, a := b.At(x, y).RGBA()
+			img.Pix[img.PixOffset(x, y)] = byte(a >> 8)
+		}
+	}
+	return img, nil
+}
+
+// RGBA returns the bitmap as an *image.RGBA, see ColorModel.
+//
+// Since FreeType stores colors as BGRA, the bitmap is always converted.
+func (b *Bitmap) RGBA() (*image.RGBA, error) {
+	if b == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	img := image.NewRGBA(b.Bounds())
+	draw.Draw(img, img.Rect, b, image.Point{}, draw.Src)
+	return img, nil
+}
+
+// NewBitmapFromImage creates a new bitmap with the given PixelMode holding a
+// copy of img, so that it can be used with functions like Blend, Embolden or
+// Convert. Rows are padded like FreeType's renderers do.
+//
+// For PixelModeMono, PixelModeGray, PixelModeGray2 and PixelModeGray4 the
+// coverage of each pixel is taken from the luminance of an *image.Gray, and
+// from the alpha channel otherwise. Other modes convert img to premultiplied
+// RGBA, PixelModeLCD and PixelModeLCDV storing the R, G and B components as
+// subpixel coverage.
+//
+// It returns ErrUnsupportedPixelMode if mode is not a valid PixelMode.
+//
+// Note that the bitmap will be freed, when destroying the library, by
+// Library.Free.
+func (l *Library) NewBitmapFromImage(img image.Image, mode PixelMode) (*Bitmap, error) {
+	if l == nil || l.ptr == nil {
+		return nil, ErrInvalidLibraryHandle
+	}
+
+	if img == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	r := img.Bounds()
+	w, h := r.Dx(), r.Dy()
+	src := &Bitmap{Rows: h, Width: w, NumGrays: 256, PixelMode: mode}
+	switch mode {
+	case PixelModeMono:
+		src.Pitch = ((w + 15) >> 4) << 1
+		src.NumGrays = 2
+	case PixelModeGray2:
+		src.Pitch = (w + 3) / 4
+		src.NumGrays = 4
+	case PixelModeGray4:
+		src.Pitch = (w + 1) / 2
+		src.NumGrays = 16
+	case PixelModeGray:
+		src.Pitch = w
+	case PixelModeLCD:
+		src.Width = 3 * w
+		src.Pitch = (3*w + 3) &^ 3
+	case PixelModeLCDV:
+		src.Rows = 3 * h
+		src.Pitch = w
+	case PixelModeBGRA:
+		src.Pitch = 4 * w
+	default:
+		return nil, ErrUnsupportedPixelMode
+	}
+	src.Buffer = make([]byte, src.Pitch*src.Rows)
+
+	if gray, ok := img.(*image.Gray); ok && src.ColorModel() == color.AlphaModel {
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				src.setAlpha(x, y, gray.GrayAt(r.Min.X+x, r.Min.Y+y).Y)
+			}
+		}
+	} else {
+		draw.Draw(src, src.Bounds(), img, r.Min, draw.Src)
+	}
+
+	ret, err := l.NewBitmap()
+	if err != nil {
+		return nil, err
+	}
+
+	csrc := C.FT_Bitmap{
+		rows:       C.uint(src.Rows),
+		width:      C.uint(src.Width),
+		pitch:      C.int(src.Pitch),
+		num_grays:  C.ushort(src.NumGrays),
+		pixel_mode: C.uchar(src.PixelMode),
+	}
+	if len(src.Buffer) > 0 {
+		csrc.buffer = (*C.uchar)(C.CBytes(src.Buffer))
+		defer free(unsafe.Pointer(csrc.buffer))
+	}
+
+	if err := getErr(C.FT_Bitmap_Copy(l.ptr, &csrc, ret.ptr)); err != nil {
+		ret.Free()
+		return nil, err
+	}
+
+	ret.reload()
+	return ret, nil
+}
@@ -0,0 +1,494 @@
+package freetype2
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io/ioutil"
+	"testing"
+)
+
+func TestBitmap_At(t *testing.T) {
+	tests := []struct {
+		name       string
+		bitmap     *Bitmap
+		wantBounds image.Rectangle
+		wantModel  color.Model
+		want       [][]color.Color
+	}{
+		{
+			name:       "nil",
+			bitmap:     nil,
+			wantBounds: image.Rectangle{},
+			wantModel:  color.AlphaModel,
+		},
+		{
+			name: "mono",
+			bitmap: &Bitmap{
+				Rows: 2, Width: 10, Pitch: 2, PixelMode: PixelModeMono,
+				Buffer: []byte{
+					0xa0, 0x40,
+					0x01, 0x80,
+				},
+			},
+			wantBounds: image.Rect(0, 0, 10, 2),
+			wantModel:  color.AlphaModel,
+			want: [][]color.Color{
+				{color.Alpha{0xff}, color.Alpha{}, color.Alpha{0xff}, color.Alpha{}, color.Alpha{}, color.Alpha{}, color.Alpha{}, color.Alpha{}, color.Alpha{}, color.Alpha{0xff}},
+				{color.Alpha{}, color.Alpha{}, color.Alpha{}, color.Alpha{}, color.Alpha{}, color.Alpha{}, color.Alpha{}, color.Alpha{0xff}, color.Alpha{0xff}, color.Alpha{}},
+			},
+		},
+		{
+			name: "gray2",
+			bitmap: &Bitmap{
+				Rows: 1, Width: 5, Pitch: 2, PixelMode: PixelModeGray2,
+				Buffer: []byte{0x1b, 0xc0},
+			},
+			wantBounds: image.Rect(0, 0, 5, 1),
+			wantModel:  color.AlphaModel,
+			want: [][]color.Color{
+				{color.Alpha{0x00}, color.Alpha{0x55}, color.Alpha{0xaa}, color.Alpha{0xff}, color.Alpha{0xff}},
+			},
+		},
+		{
+			name: "gray4",
+			bitmap: &Bitmap{
+				Rows: 1, Width: 3, Pitch: 2, PixelMode: PixelModeGray4,
+				Buffer: []byte{0x0f, 0x80},
+			},
+			wantBounds: image.Rect(0, 0, 3, 1),
+			wantModel:  color.AlphaModel,
+			want: [][]color.Color{
+				{color.Alpha{0x00}, color.Alpha{0xff}, color.Alpha{0x88}},
+			},
+		},
+		{
+			name: "gray",
+			bitmap: &Bitmap{
+				Rows: 2, Width: 2, Pitch: 4, NumGrays: 256, PixelMode: PixelModeGray,
+				Buffer: []byte{
+					0x01, 0x02, 0x00, 0x00,
+					0x03, 0x04, 0x00, 0x00,
+				},
+			},
+			wantBounds: image.Rect(0, 0, 2, 2),
+			wantModel:  color.AlphaModel,
+			want: [][]color.Color{
+				{color.Alpha{0x01}, color.Alpha{0x02}},
+				{color.Alpha{0x03}, color.Alpha{0x04}},
+			},
+		},
+		{
+			name: "gray up flow",
+			bitmap: &Bitmap{
+				Rows: 2, Width: 2, Pitch: -2, NumGrays: 256, PixelMode: PixelModeGray,
+				Buffer: []byte{
+					0x03, 0x04,
+					0x01, 0x02,
+				},
+			},
+			wantBounds: image.Rect(0, 0, 2, 2),
+			wantModel:  color.AlphaModel,
+			want: [][]color.Color{
+				{color.Alpha{0x01}, color.Alpha{0x02}},
+				{color.Alpha{0x03}, color.Alpha{0x04}},
+			},
+		},
+		{
+			name: "gray levels",
+			bitmap: &Bitmap{
+				Rows: 1, Width: 2, Pitch: 2, NumGrays: 128, PixelMode: PixelModeGray,
+				Buffer: []byte{0x7f, 0x40},
+			},
+			wantBounds: image.Rect(0, 0, 2, 1),
+			wantModel:  color.AlphaModel,
+			want: [][]color.Color{
+				{color.Alpha{0xff}, color.Alpha{0x80}},
+			},
+		},
+		{
+			name: "lcd",
+			bitmap: &Bitmap{
+				Rows: 1, Width: 6, Pitch: 8, PixelMode: PixelModeLCD,
+				Buffer: []byte{0x10, 0x20, 0x30, 0xff, 0x00, 0x00, 0x00, 0x00},
+			},
+			wantBounds: image.Rect(0, 0, 2, 1),
+			wantModel:  color.RGBAModel,
+			want: [][]color.Color{
+				{color.RGBA{0x10, 0x20, 0x30, 0x30}, color.RGBA{0xff, 0x00, 0x00, 0xff}},
+			},
+		},
+		{
+			name: "lcdv",
+			bitmap: &Bitmap{
+				Rows: 3, Width: 1, Pitch: 4, PixelMode: PixelModeLCDV,
+				Buffer: []byte{
+					0x10, 0x00, 0x00, 0x00,
+					0x20, 0x00, 0x00, 0x00,
+					0x30, 0x00, 0x00, 0x00,
+				},
+			},
+			wantBounds: image.Rect(0, 0, 1, 1),
+			wantModel:  color.RGBAModel,
+			want: [][]color.Color{
+				{color.RGBA{0x10, 0x20, 0x30, 0x30}},
+			},
+		},
+		{
+			name: "bgra",
+			bitmap: &Bitmap{
+				Rows: 1, Width: 2, Pitch: 8, PixelMode: PixelModeBGRA,
+				Buffer: []byte{0x01, 0x02, 0x03, 0x04, 0x00, 0x00, 0x80, 0x80},
+			},
+			wantBounds: image.Rect(0, 0, 2, 1),
+			wantModel:  color.RGBAModel,
+			want: [][]color.Color{
+				{color.RGBA{0x03, 0x02, 0x01, 0x04}, color.RGBA{0x80, 0x00, 0x00, 0x80}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.bitmap.Bounds(); got != tt.wantBounds {
+				t.Errorf("Bitmap.Bounds() = %v, want %v", got, tt.wantBounds)
+			}
+			if got := tt.bitmap.ColorModel(); got != tt.wantModel {
+				t.Errorf("Bitmap.ColorModel() = %v, want %v", got, tt.wantModel)
+			}
+
+			for y, row := range tt.want {
+				for x, want := range row {
+					if got := tt.bitmap.At(x, y); got != want {
+						t.Errorf("Bitmap.At(%d, %d) = %v, want %v", x, y, got, want)
+					}
+				}
+			}
+
+			// out of bounds
+			if got, want := tt.bitmap.At(-1, 0), tt.wantModel.Convert(color.Transparent); got != want {
+				t.Errorf("Bitmap.At(-1, 0) = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBitmap_Set(t *testing.T) {
+	tests := []struct {
+		name       string
+		bitmap     *Bitmap
+		colors     []color.Color
+		wantBuffer []byte
+	}{
+		{
+			name:       "mono",
+			bitmap:     &Bitmap{Rows: 1, Width: 10, Pitch: 2, PixelMode: PixelModeMono, Buffer: []byte{0x00, 0xff}},
+			colors:     []color.Color{color.Black, color.Alpha{0x7f}, color.Alpha{0x80}, color.Transparent, color.Transparent, color.Transparent, color.Transparent, color.Transparent, color.Transparent, color.Transparent},
+			wantBuffer: []byte{0xa0, 0x3f},
+		},
+		{
+			name:       "gray2",
+			bitmap:     &Bitmap{Rows: 1, Width: 4, Pitch: 1, PixelMode: PixelModeGray2, Buffer: []byte{0x00}},
+			colors:     []color.Color{color.Transparent, color.Alpha{0x55}, color.Alpha{0xaa}, color.Opaque},
+			wantBuffer: []byte{0x1b},
+		},
+		{
+			name:       "gray4",
+			bitmap:     &Bitmap{Rows: 1, Width: 2, Pitch: 1, PixelMode: PixelModeGray4, Buffer: []byte{0x00}},
+			colors:     []color.Color{color.Alpha{0x11}, color.Opaque},
+			wantBuffer: []byte{0x1f},
+		},
+		{
+			name:       "gray",
+			bitmap:     &Bitmap{Rows: 1, Width: 2, Pitch: 2, NumGrays: 256, PixelMode: PixelModeGray, Buffer: []byte{0x00, 0x00}},
+			colors:     []color.Color{color.Alpha{0x12}, color.Gray{0x34}},
+			wantBuffer: []byte{0x12, 0xff},
+		},
+		{
+			name:       "lcd",
+			bitmap:     &Bitmap{Rows: 1, Width: 3, Pitch: 4, PixelMode: PixelModeLCD, Buffer: []byte{0x00, 0x00, 0x00, 0x00}},
+			colors:     []color.Color{color.RGBA{0x10, 0x20, 0x30, 0x30}},
+			wantBuffer: []byte{0x10, 0x20, 0x30, 0x00},
+		},
+		{
+			name:       "bgra up flow",
+			bitmap:     &Bitmap{Rows: 2, Width: 1, Pitch: -4, PixelMode: PixelModeBGRA, Buffer: make([]byte, 8)},
+			colors:     []color.Color{color.NRGBA{0xff, 0x00, 0x00, 0x80}},
+			wantBuffer: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x80},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for x, c := range tt.colors {
+				tt.bitmap.Set(x, 0, c)
+			}
+			// out of bounds
+			tt.bitmap.Set(-1, 0, color.Opaque)
+			tt.bitmap.Set(0, tt.bitmap.Rows, color.Opaque)
+
+			if diff := diff(tt.bitmap.Buffer, tt.wantBuffer); diff != nil {
+				t.Errorf("Bitmap.Set() = %v", diff)
+			}
+		})
+	}
+
+	var nilBitmap *Bitmap
+	nilBitmap.Set(0, 0, color.Opaque)
+}
+
+func TestBitmap_Set_syncsFreeType(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set size: %v", err)
+	}
+	if err := face.LoadChar('A', LoadRender); err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+
+	src := face.GlyphSlot().Bitmap
+	src.Set(0, 0, color.Alpha{0x42})
+
+	dst, err := src.Convert(face.l, 1)
+	if err != nil {
+		t.Fatalf("unable to convert bitmap: %v", err)
+	}
+	defer dst.Free()
+
+	if got := dst.At(0, 0); got != (color.Alpha{0x42}) {
+		t.Errorf("Bitmap.Set() was not applied to FreeType's bitmap, got %v", got)
+	}
+
+	if err := png.Encode(ioutil.Discard, src); err != nil {
+		t.Errorf("unable to encode bitmap: %v", err)
+	}
+}
+
+func TestBitmap_Alpha(t *testing.T) {
+	gray := func(pitch int) *Bitmap {
+		b := &Bitmap{Rows: 2, Width: 2, Pitch: pitch, NumGrays: 256, PixelMode: PixelModeGray, Buffer: []byte{0x01, 0x02, 0x03, 0x04}}
+		return b
+	}
+
+	tests := []struct {
+		name       string
+		bitmap     *Bitmap
+		want       []byte
+		wantShared bool
+		wantErr    error
+	}{
+		{name: "nil", bitmap: nil, wantErr: ErrInvalidArgument},
+		{name: "gray", bitmap: gray(2), want: []byte{0x01, 0x02, 0x03, 0x04}, wantShared: true},
+		{name: "gray up flow", bitmap: gray(-2), want: []byte{0x03, 0x04, 0x01, 0x02}},
+		{
+			name:   "mono",
+			bitmap: &Bitmap{Rows: 2, Width: 2, Pitch: 1, PixelMode: PixelModeMono, Buffer: []byte{0x80, 0x40}},
+			want:   []byte{0xff, 0x00, 0x00, 0xff},
+		},
+		{
+			name:   "bgra",
+			bitmap: &Bitmap{Rows: 1, Width: 2, Pitch: 8, PixelMode: PixelModeBGRA, Buffer: []byte{0x01, 0x02, 0x03, 0x04, 0x00, 0x00, 0x80, 0x80}},
+			want:   []byte{0x04, 0x80},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alpha, err := tt.bitmap.Alpha()
+			if err != tt.wantErr {
+				t.Fatalf("Bitmap.Alpha() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			gray, err := tt.bitmap.Gray()
+			if err != tt.wantErr {
+				t.Fatalf("Bitmap.Gray() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := diff(alpha.Pix, tt.want); diff != nil {
+				t.Errorf("Bitmap.Alpha() = %v", diff)
+			}
+			if diff := diff(gray.Pix, tt.want); diff != nil {
+				t.Errorf("Bitmap.Gray() = %v", diff)
+			}
+
+			alpha.Pix[0] = 0xaa
+			if shared := tt.bitmap.Buffer[0] == 0xaa; shared != tt.wantShared {
+				t.Errorf("Bitmap.Alpha() shared = %v, want %v", shared, tt.wantShared)
+			}
+		})
+	}
+}
+
+func TestBitmap_RGBA(t *testing.T) {
+	var nilBitmap *Bitmap
+	if _, err := nilBitmap.RGBA(); err != ErrInvalidArgument {
+		t.Errorf("Bitmap.RGBA() error = %v, wantErr %v", err, ErrInvalidArgument)
+	}
+
+	b := &Bitmap{Rows: 1, Width: 2, Pitch: 8, PixelMode: PixelModeBGRA, Buffer: []byte{0x01, 0x02, 0x03, 0x04, 0x00, 0x00, 0x80, 0x80}}
+	got, err := b.RGBA()
+	if err != nil {
+		t.Fatalf("Bitmap.RGBA() error = %v", err)
+	}
+	if diff := diff(got.Pix, []byte{0x03, 0x02, 0x01, 0x04, 0x80, 0x00, 0x00, 0x80}); diff != nil {
+		t.Errorf("Bitmap.RGBA() = %v", diff)
+	}
+
+	// a glyph bitmap can be used as a mask
+	mono := &Bitmap{Rows: 1, Width: 2, Pitch: 1, PixelMode: PixelModeMono, Buffer: []byte{0x80}}
+	dst := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	draw.DrawMask(dst, dst.Rect, image.NewUniform(color.RGBA{0xff, 0, 0, 0xff}), image.Point{}, mono, image.Point{}, draw.Over)
+	if diff := diff(dst.Pix, []byte{0xff, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x00}); diff != nil {
+		t.Errorf("draw.DrawMask() = %v", diff)
+	}
+}
+
+func TestLibrary_NewBitmapFromImage(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+	defer l.Free()
+
+	gray := image.NewGray(image.Rect(10, 10, 13, 12))
+	copy(gray.Pix, []byte{
+		0x00, 0x80, 0xff,
+		0x40, 0xc0, 0x10,
+	})
+
+	alpha := image.NewAlpha(image.Rect(0, 0, 3, 2))
+	copy(alpha.Pix, gray.Pix)
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	copy(nrgba.Pix, []byte{0xff, 0x00, 0x00, 0x80, 0x00, 0x00, 0xff, 0xff})
+
+	rgba := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	copy(rgba.Pix, []byte{0x80, 0x00, 0x00, 0x80, 0x10, 0x20, 0x30, 0x40})
+
+	tests := []struct {
+		name    string
+		lib     *Library
+		img     image.Image
+		mode    PixelMode
+		want    *Bitmap
+		wantErr error
+	}{
+		{name: "nil lib", lib: nil, img: gray, mode: PixelModeGray, wantErr: ErrInvalidLibraryHandle},
+		{name: "nil image", lib: l, img: nil, mode: PixelModeGray, wantErr: ErrInvalidArgument},
+		{name: "bad mode", lib: l, img: gray, mode: 0, wantErr: ErrUnsupportedPixelMode},
+		{
+			name: "gray to gray", lib: l, img: gray, mode: PixelModeGray,
+			want: &Bitmap{
+				Rows: 2, Width: 3, Pitch: 3, NumGrays: 256, PixelMode: PixelModeGray,
+				userCreated: true,
+				Buffer:      []byte{0x00, 0x80, 0xff, 0x40, 0xc0, 0x10},
+			},
+		},
+		{
+			name: "alpha to mono", lib: l, img: alpha, mode: PixelModeMono,
+			want: &Bitmap{
+				Rows: 2, Width: 3, Pitch: 2, NumGrays: 2, PixelMode: PixelModeMono,
+				userCreated: true,
+				Buffer:      []byte{0x60, 0x00, 0x40, 0x00},
+			},
+		},
+		{
+			name: "gray to gray4", lib: l, img: gray, mode: PixelModeGray4,
+			want: &Bitmap{
+				Rows: 2, Width: 3, Pitch: 2, NumGrays: 16, PixelMode: PixelModeGray4,
+				userCreated: true,
+				Buffer:      []byte{0x07, 0xf0, 0x3b, 0x00},
+			},
+		},
+		{
+			name: "nrgba to bgra", lib: l, img: nrgba, mode: PixelModeBGRA,
+			want: &Bitmap{
+				Rows: 1, Width: 2, Pitch: 8, NumGrays: 256, PixelMode: PixelModeBGRA,
+				userCreated: true,
+				Buffer:      []byte{0x00, 0x00, 0x80, 0x80, 0xff, 0x00, 0x00, 0xff},
+			},
+		},
+		{
+			name: "rgba to alpha", lib: l, img: rgba, mode: PixelModeGray,
+			want: &Bitmap{
+				Rows: 1, Width: 2, Pitch: 2, NumGrays: 256, PixelMode: PixelModeGray,
+				userCreated: true,
+				Buffer:      []byte{0x80, 0x40},
+			},
+		},
+		{
+			name: "rgba to lcd", lib: l, img: rgba, mode: PixelModeLCD,
+			want: &Bitmap{
+				Rows: 1, Width: 6, Pitch: 8, NumGrays: 256, PixelMode: PixelModeLCD,
+				userCreated: true,
+				Buffer:      []byte{0x80, 0x00, 0x00, 0x10, 0x20, 0x30, 0x00, 0x00},
+			},
+		},
+		{
+			name: "empty", lib: l, img: image.NewGray(image.Rect(0, 0, 0, 0)), mode: PixelModeGray,
+			want: &Bitmap{NumGrays: 256, PixelMode: PixelModeGray, userCreated: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.lib.NewBitmapFromImage(tt.img, tt.mode)
+			if err != tt.wantErr {
+				t.Fatalf("Library.NewBitmapFromImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Library.NewBitmapFromImage() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestLibrary_NewBitmapFromImage_blend(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+	defer l.Free()
+
+	bg := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	draw.Draw(bg, bg.Rect, image.NewUniform(color.RGBA{0x00, 0x00, 0xff, 0xff}), image.Point{}, draw.Src)
+	target, err := l.NewBitmapFromImage(bg, PixelModeBGRA)
+	if err != nil {
+		t.Fatalf("unable to create target: %v", err)
+	}
+
+	mask := image.NewAlpha(image.Rect(0, 0, 2, 2))
+	copy(mask.Pix, []byte{0xff, 0x00, 0x00, 0xff})
+	src, err := l.NewBitmapFromImage(mask, PixelModeGray)
+	if err != nil {
+		t.Fatalf("unable to create source: %v", err)
+	}
+
+	if err := src.Embolden(l, 1<<6, 0); err != nil {
+		t.Fatalf("unable to embolden: %v", err)
+	}
+	if src.Width != 3 {
+		t.Errorf("Bitmap.Embolden() width = %v, want 3", src.Width)
+	}
+
+	// Blend needs both bitmaps to have the same flow and an offset in 26.6.
+	if _, err := src.Blend(l, Vector26_6{X: 0, Y: 2 << 6}, target, color.RGBA{0xff, 0x00, 0x00, 0xff}); err != nil {
+		t.Fatalf("unable to blend: %v", err)
+	}
+
+	got, err := target.RGBA()
+	if err != nil {
+		t.Fatalf("unable to convert target: %v", err)
+	}
+	if c := got.RGBAAt(0, 0); c != (color.RGBA{0xff, 0x00, 0x00, 0xff}) {
+		t.Errorf("Bitmap.Blend() (0, 0) = %v, want red", c)
+	}
+}
@@ -3,6 +3,8 @@ package freetype2
 // #include <ft2build.h>
 // #include FT_FREETYPE_H
 // #include FT_TRUETYPE_TABLES_H
+// #include FT_PARAMETER_TAGS_H
+// #include <stdlib.h>
 import "C"
 import (
 	"unsafe"
@@ -30,7 +32,8 @@ type Face struct {
 	lib     *Library
 	dealloc []func()
 
-	slot *GlyphSlot
+	slot      *GlyphSlot
+	synthetic SyntheticStyle
 }
 
 // Free discards the face, as well as all of its child slots and sizes.
@@ -385,6 +388,17 @@ func (f *Face) Size() *Size {
 	return ret
 }
 
+// SizeMetrics returns the metrics of the current active size for this face.
+// Unlike Size, it doesn't keep a handle to the size, so it is cheap to call
+// repeatedly.
+func (f *Face) SizeMetrics() SizeMetrics {
+	if f == nil || f.ptr == nil || f.ptr.size == nil {
+		return SizeMetrics{}
+	}
+
+	return newSize(f.ptr.size).SizeMetrics
+}
+
 // ActiveCharMap returns a copy of the active charmap.
 // If there is no active charmap, it returns the zero value and false.
 func (f *Face) ActiveCharMap() (CharMap, bool) {
@@ -626,6 +640,117 @@ func (f *Face) SetTransform(matrix Matrix, delta Vector) {
 	C.FT_Set_Transform(f.ptr, cmatrix, cdelta) // c makes a copy of matrix & delta
 }
 
+// FaceProperty is a list of the per-face properties that can be set with
+// SetProperties.
+type FaceProperty uint
+
+const (
+	// FacePropertyStemDarkening is the face's stem darkening setting.
+	FacePropertyStemDarkening FaceProperty = 1 << iota
+	// FacePropertyRandomSeed is the face's random seed.
+	FacePropertyRandomSeed
+	// FacePropertyLCDFilterWeights is the face's LCD filter.
+	FacePropertyLCDFilterWeights
+)
+
+// FaceProperties holds per-face overrides of library and driver settings.
+//
+// Nil fields are left unchanged, use Reset to restore a property to the
+// library or driver default.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_face_properties
+type FaceProperties struct {
+	// StemDarkening enables or disables stem darkening, overriding the
+	// NoStemDarkening property of the driver. It is honored by the CFF, Type 1
+	// and CID drivers, and by the autohinter in LoadTargetLight mode only.
+	StemDarkening *bool
+	// RandomSeed overrides the driver's random seed, used by the ‘random’
+	// operator of CFF2 and Type 1 fonts.
+	RandomSeed *int32
+	// LCDFilterWeights overrides the library's LCD filter, see
+	// Library.SetLCDFilterWeights. Since subpixel rendering is not enabled in
+	// the bundled static libraries, setting it returns ErrUnimplementedFeature
+	// when using them.
+	LCDFilterWeights *LCDFilterWeights
+
+	// Reset lists the properties to restore to the library or driver default.
+	// It is ignored for the properties set by the fields above.
+	Reset FaceProperty
+}
+
+// SetProperties sets or overrides certain (library or module-wide) properties
+// on a face-by-face basis. Useful for finer-grained control and avoiding locks
+// on shared structures (threads can modify their own faces as they see fit).
+//
+// Properties are applied in the order StemDarkening, RandomSeed and
+// LCDFilterWeights, FreeType stops at the first one that fails.
+//
+// Changes take effect on the next call to LoadGlyph, cached sizes are not
+// invalidated.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_face_properties
+func (f *Face) SetProperties(p FaceProperties) error {
+	if f == nil || f.ptr == nil {
+		return ErrInvalidFaceHandle
+	}
+
+	type param struct {
+		tag  C.FT_ULong
+		data unsafe.Pointer
+	}
+	var params []param
+	defer func() {
+		for _, p := range params {
+			if p.data != nil {
+				free(p.data)
+			}
+		}
+	}()
+
+	switch {
+	case p.StemDarkening != nil:
+		data := (*C.FT_Bool)(C.malloc(C.sizeof_FT_Bool))
+		*data = 0
+		if *p.StemDarkening {
+			*data = 1
+		}
+		params = append(params, param{tag: C.FT_PARAM_TAG_STEM_DARKENING, data: unsafe.Pointer(data)})
+	case p.Reset&FacePropertyStemDarkening > 0:
+		params = append(params, param{tag: C.FT_PARAM_TAG_STEM_DARKENING})
+	}
+
+	switch {
+	case p.RandomSeed != nil:
+		data := (*C.FT_Int32)(C.malloc(C.sizeof_FT_Int32))
+		*data = C.FT_Int32(*p.RandomSeed)
+		params = append(params, param{tag: C.FT_PARAM_TAG_RANDOM_SEED, data: unsafe.Pointer(data)})
+	case p.Reset&FacePropertyRandomSeed > 0:
+		params = append(params, param{tag: C.FT_PARAM_TAG_RANDOM_SEED})
+	}
+
+	switch {
+	case p.LCDFilterWeights != nil:
+		params = append(params, param{tag: C.FT_PARAM_TAG_LCD_FILTER_WEIGHTS, data: C.CBytes(p.LCDFilterWeights[:])})
+	case p.Reset&FacePropertyLCDFilterWeights > 0:
+		params = append(params, param{tag: C.FT_PARAM_TAG_LCD_FILTER_WEIGHTS})
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	cparams := (*C.FT_Parameter)(C.calloc(C.size_t(len(params)), C.sizeof_FT_Parameter))
+	defer free(unsafe.Pointer(cparams))
+
+	ptr := (*[(1<<31 - 1) / C.sizeof_FT_Parameter]C.FT_Parameter)(unsafe.Pointer(cparams))[:len(params):len(params)]
+	for i, p := range params {
+		ptr[i].tag = p.tag
+		ptr[i].data = C.FT_Pointer(p.data)
+	}
+
+	return getErr(C.FT_Face_Properties(f.ptr, C.FT_UInt(len(params)), cparams))
+}
+
 // LoadGlyph loads a glyph into the glyph slot of the face.
 //
 // The loaded glyph may be transformed. See SetTransform for the details.
@@ -636,6 +761,8 @@ func (f *Face) SetTransform(matrix Matrix, delta Vector) {
 // If you receive ErrGlyphTooBig, try getting the glyph outline at EM size, then scale it manually and fill it as a
 // graphics operation.
 //
+// Styles requested with SetSyntheticStyle are applied to the loaded glyph.
+//
 // See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_load_glyph
 func (f *Face) LoadGlyph(idx GlyphIndex, flags LoadFlag) error {
 	if f == nil || f.ptr == nil {
@@ -643,7 +770,15 @@ func (f *Face) LoadGlyph(idx GlyphIndex, flags LoadFlag) error {
 	}
 	defer f.slot.reload()
 
-	return getErr(C.FT_Load_Glyph(f.ptr, C.FT_UInt(idx), C.FT_Int32(flags)))
+	if f.SyntheticStyle() == 0 {
+		return getErr(C.FT_Load_Glyph(f.ptr, C.FT_UInt(idx), C.FT_Int32(flags)))
+	}
+
+	if err := getErr(C.FT_Load_Glyph(f.ptr, C.FT_UInt(idx), C.FT_Int32(flags&^LoadRender))); err != nil {
+		return err
+	}
+	f.slot.reload()
+	return f.synthesize(flags)
 }
 
 // CharIndex returns the glyph index of a given character code. This function
@@ -748,6 +883,8 @@ func (f *Face) IndexOf(glyphName string) GlyphIndex {
 // If no active cmap is set up (i.e., face.CharMap is zero), the call to
 // CharIndex is omitted, and the function behaves identically to LoadGlyph.
 //
+// Styles requested with SetSyntheticStyle are applied to the loaded glyph.
+//
 // See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_load_char
 func (f *Face) LoadChar(r rune, flags LoadFlag) error {
 	if f == nil || f.ptr == nil {
@@ -755,7 +892,15 @@ func (f *Face) LoadChar(r rune, flags LoadFlag) error {
 	}
 	defer f.slot.reload()
 
-	return getErr(C.FT_Load_Char(f.ptr, C.ulong(r), C.FT_Int32(flags)))
+	if f.SyntheticStyle() == 0 {
+		return getErr(C.FT_Load_Char(f.ptr, C.ulong(r), C.FT_Int32(flags)))
+	}
+
+	if err := getErr(C.FT_Load_Char(f.ptr, C.ulong(r), C.FT_Int32(flags&^LoadRender))); err != nil {
+		return err
+	}
+	f.slot.reload()
+	return f.synthesize(flags)
 }
 
 // Kern returns the kerning vector between two glyphs of the same face.
@@ -984,6 +984,26 @@ func TestFace_SetCharMap(t *testing.T) {
 	}
 }
 
+func TestFace_SizeMetrics(t *testing.T) {
+	var nilFace *Face
+	if got := nilFace.SizeMetrics(); got != (SizeMetrics{}) {
+		t.Errorf("Face.SizeMetrics() = %v, want zero value", got)
+	}
+
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetPixelSizes(0, 16); err != nil {
+		t.Fatalf("unable to set size: %v", err)
+	}
+	if diff := diff(face.SizeMetrics(), face.Size().SizeMetrics); diff != nil {
+		t.Errorf("Face.SizeMetrics() %v", diff)
+	}
+}
+
 func TestFace_Size_free(t *testing.T) {
 	face, err := goRegular()
 	if err != nil {
@@ -3249,3 +3269,72 @@ func TestFace_CharsOfVariant(t *testing.T) {
 		})
 	}
 }
+
+func TestFace_SetProperties(t *testing.T) {
+	on, off := true, false
+	seed := int32(42)
+
+	coverage := func(f testface, flags LoadFlag) int {
+		if err := f.LoadChar('l', flags|LoadRender); err != nil {
+			t.Fatalf("unable to load char: %v", err)
+		}
+
+		var n int
+		for _, b := range f.GlyphSlot().Bitmap.Buffer {
+			n += int(b)
+		}
+		return n
+	}
+
+	tests := []struct {
+		name       string
+		face       func() (testface, error)
+		props      FaceProperties
+		flags      LoadFlag
+		wantDarker bool
+		wantErr    error
+	}{
+		{name: "nilFace", face: nilFace, props: FaceProperties{}, wantErr: ErrInvalidFaceHandle},
+		{name: "empty", face: goRegular, props: FaceProperties{}, wantErr: nil},
+		{name: "type1 stem darkening", face: nimbusMono, props: FaceProperties{StemDarkening: &on}, wantDarker: true, wantErr: nil},
+		{name: "type1 no stem darkening", face: nimbusMono, props: FaceProperties{StemDarkening: &off}, wantDarker: false, wantErr: nil},
+		{name: "type1 stem darkening reset", face: nimbusMono, props: FaceProperties{Reset: FacePropertyStemDarkening}, wantDarker: false, wantErr: nil},
+		{name: "cff2 stem darkening", face: faceFromPath("variable/adobe-variable-font-prototype/AdobeVFPrototype.otf"), props: FaceProperties{StemDarkening: &on}, wantDarker: true, wantErr: nil},
+		{name: "autohinter stem darkening", face: goRegular, props: FaceProperties{StemDarkening: &on}, flags: LoadTargetLight, wantDarker: true, wantErr: nil},
+		{name: "truetype stem darkening", face: goRegular, props: FaceProperties{StemDarkening: &on}, flags: LoadDefault, wantDarker: false, wantErr: nil},
+		{name: "random seed", face: nimbusMono, props: FaceProperties{RandomSeed: &seed}, wantErr: nil},
+		{name: "random seed reset", face: nimbusMono, props: FaceProperties{Reset: FacePropertyRandomSeed}, wantErr: nil},
+		// the bundled libs use Harmony instead of subpixel filtering
+		{name: "lcd filter weights", face: goRegular, props: FaceProperties{LCDFilterWeights: &LCDFilterWeights{0x08, 0x4D, 0x56, 0x4D, 0x08}}, wantErr: ErrUnimplementedFeature},
+		{name: "lcd filter weights reset", face: goRegular, props: FaceProperties{Reset: FacePropertyLCDFilterWeights}, wantErr: ErrUnimplementedFeature},
+		{name: "stem darkening before lcd filter weights", face: nimbusMono, props: FaceProperties{StemDarkening: &on, Reset: FacePropertyLCDFilterWeights}, wantDarker: true, wantErr: ErrUnimplementedFeature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			var before int
+			if face.Face != nil {
+				if err := face.SetCharSize(12<<6, 12<<6, 72, 72); err != nil {
+					t.Fatalf("unable to set char size: %v", err)
+				}
+				before = coverage(face, tt.flags)
+			}
+
+			if err := face.SetProperties(tt.props); err != tt.wantErr {
+				t.Errorf("Face.SetProperties() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if face.Face == nil {
+				return
+			}
+			if after := coverage(face, tt.flags); (after > before) != tt.wantDarker {
+				t.Errorf("Face.SetProperties() coverage went from %d to %d, wantDarker %v", before, after, tt.wantDarker)
+			}
+		})
+	}
+}
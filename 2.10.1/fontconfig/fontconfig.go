@@ -0,0 +1,356 @@
+// Package fontconfig reads fontconfig XML configuration files, such as
+// /etc/fonts/fonts.conf, without depending on libfontconfig.
+//
+// It supports the font directories, the includes, the family aliases and the
+// match rules editing the rendering settings: hinting, hintstyle, antialias,
+// rgba, lcdfilter, embeddedbitmap and autohint. The resolved Settings map onto
+// the load flags, render modes and LCD filters of this package.
+//
+// Other elements, such as cache directories and font selection rules, are
+// ignored, as are the rules testing or editing other properties.
+//
+// See https://www.freedesktop.org/software/fontconfig/fontconfig-user.html
+package fontconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// An Alias substitutes families for Family.
+//
+// Preferred families are used before Family, accepted families right after it
+// and default families after every other family.
+type Alias struct {
+	Family  string
+	Prefer  []string
+	Accept  []string
+	Default []string
+}
+
+// Config is a fontconfig configuration.
+type Config struct {
+	// The font directories, in the order they are declared. They are not
+	// checked for existence.
+	Dirs []string
+	// The family aliases, in the order they are declared.
+	Aliases []Alias
+	// The errors of the skipped files of included directories, see Load.
+	Warnings []error
+
+	matches []match
+}
+
+// Load reads the configuration file at path, along with the files it includes.
+//
+// The files of included directories, like conf.d, that can't be read or
+// parsed are skipped and reported in Warnings, so that a single broken file
+// doesn't discard the whole configuration. Other errors fail the load.
+func Load(path string) (*Config, error) {
+	c := &Config{}
+	if err := c.load(path, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadDefault reads the configuration file used by fontconfig: the one named
+// by the FONTCONFIG_FILE environment variable, or fonts.conf in the
+// FONTCONFIG_PATH directory, or /etc/fonts/fonts.conf.
+func LoadDefault() (*Config, error) {
+	return Load(defaultFile())
+}
+
+func defaultFile() string {
+	if file := os.Getenv("FONTCONFIG_FILE"); file != "" {
+		return file
+	}
+	if dir := os.Getenv("FONTCONFIG_PATH"); dir != "" {
+		return filepath.Join(dir, "fonts.conf")
+	}
+	return "/etc/fonts/fonts.conf"
+}
+
+// load reads the configuration file at path. Files already in visited are
+// skipped, to break include cycles.
+func (c *Config) load(path string, visited map[string]bool) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	if visited[abs] {
+		return nil
+	}
+	visited[abs] = true
+
+	f, err := os.Open(abs)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := c.parse(f, filepath.Dir(abs), visited); err != nil {
+		return fmt.Errorf("fontconfig: %s: %v", abs, err)
+	}
+	return nil
+}
+
+// parse reads a configuration from r, dir being the directory of the file it
+// comes from.
+func (c *Config) parse(r io.Reader, dir string, visited map[string]bool) error {
+	d := xml.NewDecoder(r)
+
+	depth := 0
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch tok := tok.(type) {
+		case xml.EndElement:
+			depth--
+		case xml.StartElement:
+			// the root element, <fontconfig>.
+			if depth == 0 {
+				depth++
+				continue
+			}
+
+			switch tok.Name.Local {
+			case "dir":
+				var e xmlPath
+				if err := d.DecodeElement(&e, &tok); err != nil {
+					return err
+				}
+				if path := e.resolve(dir, xdgDataHome()); path != "" {
+					c.Dirs = append(c.Dirs, path)
+				}
+			case "include":
+				var e xmlInclude
+				if err := d.DecodeElement(&e, &tok); err != nil {
+					return err
+				}
+				if err := c.include(e, dir, visited); err != nil {
+					return err
+				}
+			case "alias":
+				var e xmlAlias
+				if err := d.DecodeElement(&e, &tok); err != nil {
+					return err
+				}
+				for _, family := range e.Families {
+					c.Aliases = append(c.Aliases, Alias{
+						Family:  strings.TrimSpace(family),
+						Prefer:  trimAll(e.Prefer),
+						Accept:  trimAll(e.Accept),
+						Default: trimAll(e.Default),
+					})
+				}
+			case "match":
+				var e xmlMatch
+				if err := d.DecodeElement(&e, &tok); err != nil {
+					return err
+				}
+				if m, ok := e.match(); ok {
+					c.matches = append(c.matches, m)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// include reads the file, or the configuration files of the directory, named
+// by an <include> element.
+func (c *Config) include(e xmlInclude, dir string, visited map[string]bool) error {
+	// unlike directories, includes are relative to the configuration file by
+	// default.
+	if e.Prefix == "" {
+		e.Prefix = "relative"
+	}
+	path := e.resolve(dir, xdgConfigHome())
+	if path == "" {
+		return nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if e.IgnoreMissing == "yes" && os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if !info.IsDir() {
+		return c.load(path, visited)
+	}
+
+	// like fontconfig, only the files starting with a digit and ending in
+	// .conf are read, in lexical order.
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, info := range infos {
+		name := info.Name()
+		if !info.IsDir() && name[0] >= '0' && name[0] <= '9' && strings.HasSuffix(name, ".conf") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		// the file is read separately, so that nothing of it is kept if it
+		// fails.
+		f := &Config{}
+		if err := f.load(filepath.Join(path, name), visited); err != nil {
+			c.Warnings = append(c.Warnings, err)
+			continue
+		}
+		c.Dirs = append(c.Dirs, f.Dirs...)
+		c.Aliases = append(c.Aliases, f.Aliases...)
+		c.Warnings = append(c.Warnings, f.Warnings...)
+		c.matches = append(c.matches, f.matches...)
+	}
+	return nil
+}
+
+// Families returns the families to try for family, in order, after applying
+// the aliases in order. Family names are compared ignoring case and spaces.
+func (c *Config) Families(family string) []string {
+	list := []string{family}
+	for _, a := range c.Aliases {
+		i := indexFamily(list, a.Family)
+		if i < 0 {
+			continue
+		}
+
+		var next []string
+		next = append(next, list[:i]...)
+		next = append(next, a.Prefer...)
+		next = append(next, list[i])
+		next = append(next, a.Accept...)
+		next = append(next, list[i+1:]...)
+		next = append(next, a.Default...)
+		list = next
+	}
+
+	// only the first occurrence of a family matters.
+	var ret []string
+	for _, f := range list {
+		if indexFamily(ret, f) < 0 {
+			ret = append(ret, f)
+		}
+	}
+	return ret
+}
+
+func indexFamily(list []string, family string) int {
+	for i, f := range list {
+		if equalFamily(f, family) {
+			return i
+		}
+	}
+	return -1
+}
+
+// equalFamily compares family names like fontconfig, ignoring case and
+// spaces.
+func equalFamily(a, b string) bool {
+	strip := strings.NewReplacer(" ", "")
+	return strings.EqualFold(strip.Replace(a), strip.Replace(b))
+}
+
+func trimAll(list []string) []string {
+	var ret []string
+	for _, s := range list {
+		ret = append(ret, strings.TrimSpace(s))
+	}
+	return ret
+}
+
+// xmlPath is a <dir> or <include> element.
+type xmlPath struct {
+	Prefix string `xml:"prefix,attr"`
+	Path   string `xml:",chardata"`
+}
+
+// resolve returns the absolute path named by the element, dir being the
+// directory of the configuration file and xdg the XDG base directory used by
+// the ‘xdg’ prefix. Relative paths without a prefix are relative to the
+// working directory. It returns an empty string if the path cannot be
+// resolved.
+func (e xmlPath) resolve(dir, xdg string) string {
+	path := strings.TrimSpace(e.Path)
+	if path == "" {
+		return ""
+	}
+
+	switch {
+	case e.Prefix == "xdg":
+		if xdg == "" {
+			return ""
+		}
+		path = filepath.Join(xdg, path)
+	case path == "~" || strings.HasPrefix(path, "~/"):
+		home := os.Getenv("HOME")
+		if home == "" {
+			return ""
+		}
+		path = filepath.Join(home, path[1:])
+	case filepath.IsAbs(path):
+	case e.Prefix == "relative":
+		path = filepath.Join(dir, path)
+	default:
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return ""
+		}
+		path = abs
+	}
+	return filepath.Clean(path)
+}
+
+type xmlInclude struct {
+	xmlPath
+	IgnoreMissing string `xml:"ignore_missing,attr"`
+}
+
+type xmlAlias struct {
+	Families []string `xml:"family"`
+	Prefer   []string `xml:"prefer>family"`
+	Accept   []string `xml:"accept>family"`
+	Default  []string `xml:"default>family"`
+}
+
+// xdgDataHome and xdgConfigHome return the XDG base directories, or an empty
+// string if they cannot be determined.
+func xdgDataHome() string {
+	return xdgHome("XDG_DATA_HOME", ".local/share")
+}
+
+func xdgConfigHome() string {
+	return xdgHome("XDG_CONFIG_HOME", ".config")
+}
+
+func xdgHome(env, def string) string {
+	if dir := os.Getenv(env); dir != "" {
+		return dir
+	}
+	if home := os.Getenv("HOME"); home != "" {
+		return filepath.Join(home, def)
+	}
+	return ""
+}
@@ -0,0 +1,302 @@
+package fontconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates files in a temporary directory, the returned function
+// removes it.
+func writeFiles(t *testing.T, files map[string]string) (string, func()) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "fontconfig")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			os.RemoveAll(dir)
+			t.Fatalf("unable to create dir: %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			os.RemoveAll(dir)
+			t.Fatalf("unable to write file: %v", err)
+		}
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+// setenv sets the environment variables in env, the returned function
+// restores them.
+func setenv(env map[string]string) func() {
+	old := make(map[string]*string)
+	for k, v := range env {
+		if prev, ok := os.LookupEnv(k); ok {
+			old[k] = &prev
+		} else {
+			old[k] = nil
+		}
+		os.Setenv(k, v)
+	}
+	return func() {
+		for k, v := range old {
+			if v == nil {
+				os.Unsetenv(k)
+			} else {
+				os.Setenv(k, *v)
+			}
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir, cleanup := writeFiles(t, map[string]string{
+		"fonts.conf": `<?xml version="1.0"?>
+<!DOCTYPE fontconfig SYSTEM "fonts.dtd">
+<fontconfig>
+	<dir>/usr/share/fonts</dir>
+	<dir prefix="xdg">fonts</dir>
+	<dir>~/.fonts</dir>
+	<dir prefix="relative">local</dir>
+	<cachedir>/var/cache/fontconfig</cachedir>
+	<include ignore_missing="yes">conf.d</include>
+	<include ignore_missing="yes">missing.conf</include>
+	<include>extra.conf</include>
+	<alias>
+		<family>serif</family>
+		<prefer><family>Go</family></prefer>
+	</alias>
+</fontconfig>`,
+		"conf.d/10-first.conf": `<fontconfig>
+	<dir>/first</dir>
+</fontconfig>`,
+		"conf.d/20-second.conf": `<fontconfig>
+	<dir>/second</dir>
+	<include>../fonts.conf</include>
+</fontconfig>`,
+		"conf.d/README":         `not a configuration file`,
+		"conf.d/second.conf":    `<fontconfig><dir>/ignored</dir></fontconfig>`,
+		"conf.d/30-third.conf~": `<fontconfig><dir>/ignored</dir></fontconfig>`,
+		"extra.conf": `<fontconfig>
+	<dir>/extra</dir>
+	<alias>
+		<family>sans-serif</family>
+		<family>sans</family>
+		<prefer><family> Go </family></prefer>
+		<accept><family>Noto Sans</family></accept>
+		<default><family>DejaVu Sans</family></default>
+	</alias>
+</fontconfig>`,
+	})
+	defer cleanup()
+	defer setenv(map[string]string{
+		"HOME":          "/home/user",
+		"XDG_DATA_HOME": "/home/user/data",
+	})()
+
+	got, err := Load(filepath.Join(dir, "fonts.conf"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	wantDirs := []string{
+		"/usr/share/fonts",
+		"/home/user/data/fonts",
+		"/home/user/.fonts",
+		filepath.Join(dir, "local"),
+		"/first",
+		"/second",
+		"/extra",
+	}
+	if !reflect.DeepEqual(got.Dirs, wantDirs) {
+		t.Errorf("Load() Dirs = %v, want %v", got.Dirs, wantDirs)
+	}
+
+	wantAliases := []Alias{
+		{Family: "sans-serif", Prefer: []string{"Go"}, Accept: []string{"Noto Sans"}, Default: []string{"DejaVu Sans"}},
+		{Family: "sans", Prefer: []string{"Go"}, Accept: []string{"Noto Sans"}, Default: []string{"DejaVu Sans"}},
+		{Family: "serif", Prefer: []string{"Go"}},
+	}
+	if !reflect.DeepEqual(got.Aliases, wantAliases) {
+		t.Errorf("Load() Aliases = %v, want %v", got.Aliases, wantAliases)
+	}
+	if len(got.Warnings) != 0 {
+		t.Errorf("Load() Warnings = %v, want none", got.Warnings)
+	}
+}
+
+func TestLoad_errors(t *testing.T) {
+	dir, cleanup := writeFiles(t, map[string]string{
+		"missing.conf": `<fontconfig><include>nothere.conf</include></fontconfig>`,
+		"invalid.conf": `<fontconfig><dir>/usr/share/fonts</fontconfig>`,
+	})
+	defer cleanup()
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "no file", path: filepath.Join(dir, "nothere.conf")},
+		{name: "missing include", path: filepath.Join(dir, "missing.conf")},
+		{name: "invalid xml", path: filepath.Join(dir, "invalid.conf")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Load(tt.path)
+			if err == nil {
+				t.Errorf("Load() error = nil, want an error")
+			}
+			if got != nil {
+				t.Errorf("Load() = %v, want nil", got)
+			}
+		})
+	}
+}
+
+func TestLoad_warnings(t *testing.T) {
+	dir, cleanup := writeFiles(t, map[string]string{
+		"fonts.conf": `<fontconfig>
+	<dir>/first</dir>
+	<include>conf.d</include>
+	<dir>/last</dir>
+</fontconfig>`,
+		"conf.d/10-valid.conf":   `<fontconfig><dir>/valid</dir></fontconfig>`,
+		"conf.d/20-invalid.conf": `<fontconfig><dir>/invalid</dir><dir>/unclosed</fontconfig>`,
+		"conf.d/30-missing.conf": `<fontconfig><dir>/missing</dir><include>nothere.conf</include></fontconfig>`,
+		"conf.d/40-valid.conf":   `<fontconfig><dir>/after</dir></fontconfig>`,
+	})
+	defer cleanup()
+
+	got, err := Load(filepath.Join(dir, "fonts.conf"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	// nothing is kept of the skipped files.
+	wantDirs := []string{"/first", "/valid", "/after", "/last"}
+	if !reflect.DeepEqual(got.Dirs, wantDirs) {
+		t.Errorf("Load() Dirs = %v, want %v", got.Dirs, wantDirs)
+	}
+
+	wantFiles := []string{"20-invalid.conf", "30-missing.conf"}
+	if len(got.Warnings) != len(wantFiles) {
+		t.Fatalf("Load() Warnings = %v, want %d warnings", got.Warnings, len(wantFiles))
+	}
+	for i, file := range wantFiles {
+		if !strings.Contains(got.Warnings[i].Error(), file) {
+			t.Errorf("Load() Warnings[%d] = %v, want a warning for %s", i, got.Warnings[i], file)
+		}
+	}
+}
+
+func TestLoadDefault(t *testing.T) {
+	dir, cleanup := writeFiles(t, map[string]string{
+		"fonts.conf": `<fontconfig><dir>/path</dir></fontconfig>`,
+		"file.conf":  `<fontconfig><dir>/file</dir></fontconfig>`,
+	})
+	defer cleanup()
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		want []string
+	}{
+		{
+			name: "FONTCONFIG_FILE",
+			env:  map[string]string{"FONTCONFIG_FILE": filepath.Join(dir, "file.conf"), "FONTCONFIG_PATH": dir},
+			want: []string{"/file"},
+		},
+		{
+			name: "FONTCONFIG_PATH",
+			env:  map[string]string{"FONTCONFIG_FILE": "", "FONTCONFIG_PATH": dir},
+			want: []string{"/path"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setenv(tt.env)()
+
+			got, err := LoadDefault()
+			if err != nil {
+				t.Fatalf("LoadDefault() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Dirs, tt.want) {
+				t.Errorf("LoadDefault() Dirs = %v, want %v", got.Dirs, tt.want)
+			}
+		})
+	}
+}
+
+func TestXmlPath_resolve(t *testing.T) {
+	defer setenv(map[string]string{"HOME": "/home/user"})()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("unable to get working dir: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		e    xmlPath
+		xdg  string
+		want string
+	}{
+		{name: "empty", e: xmlPath{Path: " "}, want: ""},
+		{name: "absolute", e: xmlPath{Path: "/usr/share/fonts/"}, want: "/usr/share/fonts"},
+		{name: "absolute relative", e: xmlPath{Prefix: "relative", Path: "/usr/share/fonts"}, want: "/usr/share/fonts"},
+		{name: "home", e: xmlPath{Path: "~/.fonts"}, want: "/home/user/.fonts"},
+		{name: "home only", e: xmlPath{Path: "~"}, want: "/home/user"},
+		{name: "relative", e: xmlPath{Prefix: "relative", Path: "fonts"}, want: "/etc/fonts/fonts"},
+		{name: "cwd", e: xmlPath{Path: "fonts"}, want: filepath.Join(wd, "fonts")},
+		{name: "default", e: xmlPath{Prefix: "default", Path: "fonts"}, want: filepath.Join(wd, "fonts")},
+		{name: "xdg", e: xmlPath{Prefix: "xdg", Path: "fonts"}, xdg: "/home/user/.local/share", want: "/home/user/.local/share/fonts"},
+		{name: "xdg unknown", e: xmlPath{Prefix: "xdg", Path: "fonts"}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.resolve("/etc/fonts", tt.xdg); got != tt.want {
+				t.Errorf("xmlPath.resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_Families(t *testing.T) {
+	c := &Config{
+		Aliases: []Alias{
+			{Family: "sans-serif", Prefer: []string{"Go", "Noto Sans"}, Default: []string{"DejaVu Sans"}},
+			{Family: "Go", Accept: []string{"Go Medium"}},
+			{Family: "serif", Prefer: []string{"Noto Serif"}, Accept: []string{"DejaVu Serif"}},
+			{Family: "Courier", Accept: []string{"monospace"}},
+			{Family: "monospace", Prefer: []string{"Go Mono"}},
+			{Family: "Noto Sans", Prefer: []string{"Go"}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		family string
+		want   []string
+	}{
+		{name: "no alias", family: "Helvetica", want: []string{"Helvetica"}},
+		{name: "prefer default", family: "sans-serif", want: []string{"Go", "Go Medium", "Noto Sans", "sans-serif", "DejaVu Sans"}},
+		{name: "accept", family: "Go", want: []string{"Go", "Go Medium"}},
+		{name: "prefer accept", family: "serif", want: []string{"Noto Serif", "serif", "DejaVu Serif"}},
+		{name: "chained", family: "Courier", want: []string{"Courier", "Go Mono", "monospace"}},
+		{name: "case and spaces", family: "Sans-Serif", want: []string{"Go", "Go Medium", "Noto Sans", "Sans-Serif", "DejaVu Sans"}},
+		{name: "spaces", family: "NotoSans", want: []string{"Go", "NotoSans"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Families(tt.family); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Config.Families() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
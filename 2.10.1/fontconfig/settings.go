@@ -0,0 +1,468 @@
+package fontconfig
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+
+	freetype2 "github.com/flga/freetype2/2.10.1"
+)
+
+// HintStyle is the amount of hinting applied to glyphs.
+type HintStyle int
+
+const (
+	// HintNone disables hinting.
+	HintNone HintStyle = iota
+	// HintSlight fits glyphs to the pixel grid vertically only.
+	HintSlight
+	// HintMedium is handled like HintFull, FreeType has no intermediate
+	// hinting mode.
+	HintMedium
+	// HintFull fits glyphs to the pixel grid in both directions.
+	HintFull
+)
+
+func (h HintStyle) String() string {
+	switch h {
+	case HintNone:
+		return "None"
+	case HintSlight:
+		return "Slight"
+	case HintMedium:
+		return "Medium"
+	case HintFull:
+		return "Full"
+	default:
+		return "Unknown"
+	}
+}
+
+// RGBA is the subpixel order of the display.
+type RGBA int
+
+const (
+	// RGBAUnknown is used when the subpixel order is not known, subpixel
+	// rendering is not used.
+	RGBAUnknown RGBA = iota
+	// RGBARGB is used for horizontal subpixels, red first.
+	RGBARGB
+	// RGBABGR is used for horizontal subpixels, blue first.
+	RGBABGR
+	// RGBAVRGB is used for vertical subpixels, red on top.
+	RGBAVRGB
+	// RGBAVBGR is used for vertical subpixels, blue on top.
+	RGBAVBGR
+	// RGBANone disables subpixel rendering.
+	RGBANone
+)
+
+func (r RGBA) String() string {
+	switch r {
+	case RGBAUnknown:
+		return "Unknown"
+	case RGBARGB:
+		return "RGB"
+	case RGBABGR:
+		return "BGR"
+	case RGBAVRGB:
+		return "VRGB"
+	case RGBAVBGR:
+		return "VBGR"
+	case RGBANone:
+		return "None"
+	default:
+		return "Unknown"
+	}
+}
+
+// Settings are the rendering preferences resolved from a configuration.
+type Settings struct {
+	Hinting        bool
+	HintStyle      HintStyle
+	Antialias      bool
+	RGBA           RGBA
+	LCDFilter      freetype2.LCDFilter
+	EmbeddedBitmap bool
+	Autohint       bool
+}
+
+// defaultSettings are the settings fontconfig and its clients use for the
+// properties left unset by the configuration.
+var defaultSettings = Settings{
+	Hinting:        true,
+	HintStyle:      HintFull,
+	Antialias:      true,
+	RGBA:           RGBAUnknown,
+	LCDFilter:      freetype2.LCDFilterDefault,
+	EmbeddedBitmap: true,
+	Autohint:       false,
+}
+
+// subpixel reports whether s uses subpixel rendering, and whether it is
+// vertical.
+func (s Settings) subpixel() (ok, vertical bool) {
+	if !s.Antialias {
+		return false, false
+	}
+	switch s.RGBA {
+	case RGBARGB, RGBABGR:
+		return true, false
+	case RGBAVRGB, RGBAVBGR:
+		return true, true
+	}
+	return false, false
+}
+
+// LoadFlags returns the flags to pass to Face.LoadGlyph, like cairo does.
+//
+// Subpixel hinting is only used with HintFull and HintMedium, HintSlight
+// always uses the light hinting target.
+func (s Settings) LoadFlags() freetype2.LoadFlag {
+	flags := freetype2.LoadDefault
+	if !s.EmbeddedBitmap {
+		flags |= freetype2.LoadNoBitmap
+	}
+	if s.Autohint {
+		flags |= freetype2.LoadForceAutohint
+	}
+
+	if !s.Hinting || s.HintStyle == HintNone {
+		return flags | freetype2.LoadNoHinting
+	}
+	if !s.Antialias {
+		return flags | freetype2.LoadTargetMono
+	}
+	if s.HintStyle == HintSlight {
+		return flags | freetype2.LoadTargetLight
+	}
+	if ok, vertical := s.subpixel(); ok && vertical {
+		return flags | freetype2.LoadTargetLCDV
+	} else if ok {
+		return flags | freetype2.LoadTargetLCD
+	}
+	return flags | freetype2.LoadTargetNormal
+}
+
+// RenderMode returns the mode to pass to GlyphSlot.Render.
+//
+// LCD bitmaps are always rendered in RGB order, they have to be swapped when
+// RGBA is RGBABGR or RGBAVBGR.
+func (s Settings) RenderMode() freetype2.RenderMode {
+	if !s.Antialias {
+		return freetype2.RenderModeMono
+	}
+	if ok, vertical := s.subpixel(); ok && vertical {
+		return freetype2.RenderModeLCDV
+	} else if ok {
+		return freetype2.RenderModeLCD
+	}
+	if s.Hinting && s.HintStyle == HintSlight {
+		return freetype2.RenderModeLight
+	}
+	return freetype2.RenderModeNormal
+}
+
+// Pattern describes the text the settings are resolved for. Zero fields are
+// left out, so that the rules testing them don't match.
+type Pattern struct {
+	Family string
+	Style  string
+	// The size in points.
+	Size float64
+	// The size in pixels.
+	PixelSize float64
+}
+
+// Settings resolves the rendering settings for p, applying the match rules
+// of the configuration in order.
+func (c *Config) Settings(p Pattern) Settings {
+	props := make(map[string]value)
+	if p.Family != "" {
+		props["family"] = value{kind: kindString, s: p.Family}
+	}
+	if p.Style != "" {
+		props["style"] = value{kind: kindString, s: p.Style}
+	}
+	if p.Size != 0 {
+		props["size"] = value{kind: kindNumber, n: p.Size}
+	}
+	if p.PixelSize != 0 {
+		props["pixelsize"] = value{kind: kindNumber, n: p.PixelSize}
+	}
+
+	if c != nil {
+		for _, m := range c.matches {
+			m.apply(props)
+		}
+	}
+
+	s := defaultSettings
+	if v, ok := props["hinting"]; ok && v.kind == kindBool {
+		s.Hinting = v.b
+	}
+	if v, ok := props["hintstyle"]; ok && v.kind == kindNumber && v.n >= float64(HintNone) && v.n <= float64(HintFull) {
+		s.HintStyle = HintStyle(v.n)
+	}
+	if v, ok := props["antialias"]; ok && v.kind == kindBool {
+		s.Antialias = v.b
+	}
+	if v, ok := props["rgba"]; ok && v.kind == kindNumber && v.n >= float64(RGBAUnknown) && v.n <= float64(RGBANone) {
+		s.RGBA = RGBA(v.n)
+	}
+	if v, ok := props["lcdfilter"]; ok && v.kind == kindNumber {
+		if f, ok := lcdFilters[v.n]; ok {
+			s.LCDFilter = f
+		}
+	}
+	if v, ok := props["embeddedbitmap"]; ok && v.kind == kindBool {
+		s.EmbeddedBitmap = v.b
+	}
+	if v, ok := props["autohint"]; ok && v.kind == kindBool {
+		s.Autohint = v.b
+	}
+	return s
+}
+
+// lcdFilters maps the values of the lcdfilter constants to LCD filters.
+var lcdFilters = map[float64]freetype2.LCDFilter{
+	0: freetype2.LCDFilterNone,
+	1: freetype2.LCDFilterDefault,
+	2: freetype2.LCDFilterLight,
+	3: freetype2.LCDFilterLegacy,
+}
+
+// constants maps the supported constants to their values, along with the
+// property they apply to.
+var constants = map[string]struct {
+	property string
+	value    int
+}{
+	"hintnone":   {"hintstyle", int(HintNone)},
+	"hintslight": {"hintstyle", int(HintSlight)},
+	"hintmedium": {"hintstyle", int(HintMedium)},
+	"hintfull":   {"hintstyle", int(HintFull)},
+	"unknown":    {"rgba", int(RGBAUnknown)},
+	"rgb":        {"rgba", int(RGBARGB)},
+	"bgr":        {"rgba", int(RGBABGR)},
+	"vrgb":       {"rgba", int(RGBAVRGB)},
+	"vbgr":       {"rgba", int(RGBAVBGR)},
+	"none":       {"rgba", int(RGBANone)},
+	"lcdnone":    {"lcdfilter", 0},
+	"lcddefault": {"lcdfilter", 1},
+	"lcdlight":   {"lcdfilter", 2},
+	"lcdlegacy":  {"lcdfilter", 3},
+}
+
+// properties lists the kind of the supported properties.
+var properties = map[string]kind{
+	"family":         kindString,
+	"style":          kindString,
+	"size":           kindNumber,
+	"pixelsize":      kindNumber,
+	"hinting":        kindBool,
+	"hintstyle":      kindNumber,
+	"antialias":      kindBool,
+	"rgba":           kindNumber,
+	"lcdfilter":      kindNumber,
+	"embeddedbitmap": kindBool,
+	"autohint":       kindBool,
+}
+
+type kind int
+
+const (
+	kindString kind = iota
+	kindNumber
+	kindBool
+)
+
+type value struct {
+	kind kind
+	s    string
+	n    float64
+	b    bool
+}
+
+// parseValue parses a <string>, <int>, <double>, <bool> or <const> element,
+// for the given property. Constants of other properties are rejected.
+func parseValue(e xmlValue, property string) (value, bool) {
+	text := strings.TrimSpace(e.Text)
+	switch e.XMLName.Local {
+	case "string":
+		return value{kind: kindString, s: e.Text}, true
+	case "int", "double":
+		n, err := strconv.ParseFloat(text, 64)
+		return value{kind: kindNumber, n: n}, err == nil
+	case "bool":
+		switch strings.ToLower(text) {
+		case "true", "yes", "on", "t", "y", "1":
+			return value{kind: kindBool, b: true}, true
+		case "false", "no", "off", "f", "n", "0":
+			return value{kind: kindBool, b: false}, true
+		}
+	case "const":
+		if c, ok := constants[text]; ok && c.property == property {
+			return value{kind: kindNumber, n: float64(c.value)}, true
+		}
+	}
+	return value{}, false
+}
+
+// compare applies a <test> comparison between the property v and the test
+// value t. Values of different kinds never match.
+func compare(op string, v, t value) bool {
+	if v.kind != t.kind {
+		return false
+	}
+
+	switch v.kind {
+	case kindString:
+		switch op {
+		case "", "eq":
+			return equalFamily(v.s, t.s)
+		case "not_eq":
+			return !equalFamily(v.s, t.s)
+		case "contains":
+			return strings.Contains(strings.ToLower(v.s), strings.ToLower(t.s))
+		case "not_contains":
+			return !strings.Contains(strings.ToLower(v.s), strings.ToLower(t.s))
+		}
+	case kindNumber:
+		switch op {
+		case "", "eq", "contains":
+			return v.n == t.n
+		case "not_eq", "not_contains":
+			return v.n != t.n
+		case "less":
+			return v.n < t.n
+		case "less_eq":
+			return v.n <= t.n
+		case "more":
+			return v.n > t.n
+		case "more_eq":
+			return v.n >= t.n
+		}
+	case kindBool:
+		switch op {
+		case "", "eq", "contains":
+			return v.b == t.b
+		case "not_eq", "not_contains":
+			return v.b != t.b
+		}
+	}
+	return false
+}
+
+// match is a <match> element reduced to the supported tests and edits.
+type match struct {
+	tests []test
+	edits []edit
+}
+
+type test struct {
+	property string
+	compare  string
+	value    value
+}
+
+type edit struct {
+	property string
+	mode     string
+	value    value
+}
+
+// apply edits props if all the tests of m pass. Tests on properties missing
+// from props fail.
+func (m match) apply(props map[string]value) {
+	for _, t := range m.tests {
+		v, ok := props[t.property]
+		if !ok || !compare(t.compare, v, t.value) {
+			return
+		}
+	}
+
+	for _, e := range m.edits {
+		_, set := props[e.property]
+		switch e.mode {
+		case "delete", "delete_all":
+			delete(props, e.property)
+		case "append", "append_last":
+			// appended values only matter when the property is unset.
+			if !set {
+				props[e.property] = e.value
+			}
+		default:
+			props[e.property] = e.value
+		}
+	}
+}
+
+type xmlValue struct {
+	XMLName xml.Name
+	Text    string `xml:",chardata"`
+}
+
+type xmlTest struct {
+	Name    string     `xml:"name,attr"`
+	Compare string     `xml:"compare,attr"`
+	Values  []xmlValue `xml:",any"`
+}
+
+type xmlEdit struct {
+	Name   string     `xml:"name,attr"`
+	Mode   string     `xml:"mode,attr"`
+	Values []xmlValue `xml:",any"`
+}
+
+type xmlMatch struct {
+	Target string    `xml:"target,attr"`
+	Tests  []xmlTest `xml:"test"`
+	Edits  []xmlEdit `xml:"edit"`
+}
+
+// match converts the element, it reports false if the rule cannot be
+// evaluated: it applies when scanning fonts, or tests an unsupported property
+// or expression. Unsupported edits are dropped.
+func (e xmlMatch) match() (match, bool) {
+	if e.Target == "scan" {
+		return match{}, false
+	}
+
+	var m match
+	for _, t := range e.Tests {
+		if _, ok := properties[t.Name]; !ok || len(t.Values) != 1 {
+			return match{}, false
+		}
+		v, ok := parseValue(t.Values[0], t.Name)
+		if !ok {
+			return match{}, false
+		}
+		m.tests = append(m.tests, test{property: t.Name, compare: t.Compare, value: v})
+	}
+
+	for _, ed := range e.Edits {
+		k, ok := properties[ed.Name]
+		if !ok {
+			continue
+		}
+		if ed.Mode == "delete" || ed.Mode == "delete_all" {
+			m.edits = append(m.edits, edit{property: ed.Name, mode: ed.Mode})
+			continue
+		}
+		if len(ed.Values) == 0 {
+			continue
+		}
+		// only the first value of a list matters.
+		v, ok := parseValue(ed.Values[0], ed.Name)
+		if !ok || v.kind != k {
+			continue
+		}
+		m.edits = append(m.edits, edit{property: ed.Name, mode: ed.Mode, value: v})
+	}
+
+	if len(m.edits) == 0 {
+		return match{}, false
+	}
+	return m, true
+}
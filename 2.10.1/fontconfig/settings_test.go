@@ -0,0 +1,260 @@
+package fontconfig
+
+import (
+	"path/filepath"
+	"testing"
+
+	freetype2 "github.com/flga/freetype2/2.10.1"
+)
+
+func TestHintStyle_String(t *testing.T) {
+	tests := []struct {
+		name string
+		x    HintStyle
+		want string
+	}{
+		{name: "None", x: HintNone, want: "None"},
+		{name: "Slight", x: HintSlight, want: "Slight"},
+		{name: "Medium", x: HintMedium, want: "Medium"},
+		{name: "Full", x: HintFull, want: "Full"},
+		{name: "Unknown", x: HintFull + 1, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("HintStyle.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGBA_String(t *testing.T) {
+	tests := []struct {
+		name string
+		x    RGBA
+		want string
+	}{
+		{name: "Unknown", x: RGBAUnknown, want: "Unknown"},
+		{name: "RGB", x: RGBARGB, want: "RGB"},
+		{name: "BGR", x: RGBABGR, want: "BGR"},
+		{name: "VRGB", x: RGBAVRGB, want: "VRGB"},
+		{name: "VBGR", x: RGBAVBGR, want: "VBGR"},
+		{name: "None", x: RGBANone, want: "None"},
+		{name: "out of range", x: RGBANone + 1, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("RGBA.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSettings_LoadFlags(t *testing.T) {
+	with := func(f func(s *Settings)) Settings {
+		s := defaultSettings
+		f(&s)
+		return s
+	}
+
+	tests := []struct {
+		name string
+		s    Settings
+		want freetype2.LoadFlag
+	}{
+		{name: "default", s: defaultSettings, want: freetype2.LoadTargetNormal},
+		{name: "no hinting", s: with(func(s *Settings) { s.Hinting = false }), want: freetype2.LoadNoHinting},
+		{name: "hintnone", s: with(func(s *Settings) { s.HintStyle = HintNone }), want: freetype2.LoadNoHinting},
+		{name: "hintslight", s: with(func(s *Settings) { s.HintStyle = HintSlight }), want: freetype2.LoadTargetLight},
+		{name: "hintmedium", s: with(func(s *Settings) { s.HintStyle = HintMedium }), want: freetype2.LoadTargetNormal},
+		{name: "mono", s: with(func(s *Settings) { s.Antialias = false }), want: freetype2.LoadTargetMono},
+		{name: "mono rgb", s: with(func(s *Settings) { s.Antialias, s.RGBA = false, RGBARGB }), want: freetype2.LoadTargetMono},
+		{name: "rgb", s: with(func(s *Settings) { s.RGBA = RGBARGB }), want: freetype2.LoadTargetLCD},
+		{name: "bgr", s: with(func(s *Settings) { s.RGBA = RGBABGR }), want: freetype2.LoadTargetLCD},
+		{name: "vrgb", s: with(func(s *Settings) { s.RGBA = RGBAVRGB }), want: freetype2.LoadTargetLCDV},
+		{name: "vbgr", s: with(func(s *Settings) { s.RGBA = RGBAVBGR }), want: freetype2.LoadTargetLCDV},
+		{name: "rgba none", s: with(func(s *Settings) { s.RGBA = RGBANone }), want: freetype2.LoadTargetNormal},
+		{name: "rgb hintslight", s: with(func(s *Settings) { s.RGBA, s.HintStyle = RGBARGB, HintSlight }), want: freetype2.LoadTargetLight},
+		{name: "no embedded bitmap", s: with(func(s *Settings) { s.EmbeddedBitmap = false }), want: freetype2.LoadNoBitmap | freetype2.LoadTargetNormal},
+		{name: "autohint", s: with(func(s *Settings) { s.Autohint = true }), want: freetype2.LoadForceAutohint | freetype2.LoadTargetNormal},
+		{name: "autohint no hinting", s: with(func(s *Settings) { s.Autohint, s.Hinting = true, false }), want: freetype2.LoadForceAutohint | freetype2.LoadNoHinting},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.LoadFlags(); got != tt.want {
+				t.Errorf("Settings.LoadFlags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSettings_RenderMode(t *testing.T) {
+	with := func(f func(s *Settings)) Settings {
+		s := defaultSettings
+		f(&s)
+		return s
+	}
+
+	tests := []struct {
+		name string
+		s    Settings
+		want freetype2.RenderMode
+	}{
+		{name: "default", s: defaultSettings, want: freetype2.RenderModeNormal},
+		{name: "mono", s: with(func(s *Settings) { s.Antialias = false }), want: freetype2.RenderModeMono},
+		{name: "mono rgb", s: with(func(s *Settings) { s.Antialias, s.RGBA = false, RGBARGB }), want: freetype2.RenderModeMono},
+		{name: "hintslight", s: with(func(s *Settings) { s.HintStyle = HintSlight }), want: freetype2.RenderModeLight},
+		{name: "hintslight no hinting", s: with(func(s *Settings) { s.HintStyle, s.Hinting = HintSlight, false }), want: freetype2.RenderModeNormal},
+		{name: "rgb", s: with(func(s *Settings) { s.RGBA = RGBARGB }), want: freetype2.RenderModeLCD},
+		{name: "bgr hintslight", s: with(func(s *Settings) { s.RGBA, s.HintStyle = RGBABGR, HintSlight }), want: freetype2.RenderModeLCD},
+		{name: "vrgb", s: with(func(s *Settings) { s.RGBA = RGBAVRGB }), want: freetype2.RenderModeLCDV},
+		{name: "vbgr", s: with(func(s *Settings) { s.RGBA = RGBAVBGR }), want: freetype2.RenderModeLCDV},
+		{name: "rgba none", s: with(func(s *Settings) { s.RGBA = RGBANone }), want: freetype2.RenderModeNormal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.RenderMode(); got != tt.want {
+				t.Errorf("Settings.RenderMode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_Settings(t *testing.T) {
+	dir, cleanup := writeFiles(t, map[string]string{
+		"fonts.conf": `<fontconfig>
+	<include>conf.d</include>
+	<match target="pattern">
+		<edit name="rgba" mode="assign"><const>rgb</const></edit>
+		<edit name="hintstyle" mode="assign"><const>hintslight</const></edit>
+		<edit name="lcdfilter" mode="assign"><const>lcddefault</const></edit>
+	</match>
+	<match target="font">
+		<test name="family" compare="eq"><string>go mono</string></test>
+		<edit name="hinting" mode="assign"><bool>false</bool></edit>
+		<edit name="lcdfilter" mode="assign"><const>lcdlight</const></edit>
+	</match>
+	<match target="font">
+		<test name="pixelsize" compare="less"><double>12</double></test>
+		<edit name="antialias" mode="assign"><bool>false</bool></edit>
+	</match>
+	<match target="font">
+		<test name="family" compare="contains"><string>Bitmap</string></test>
+		<edit name="embeddedbitmap" mode="assign"><bool>true</bool></edit>
+		<edit name="autohint" mode="assign"><bool>yes</bool></edit>
+		<edit name="rgba" mode="delete"/>
+	</match>
+	<match target="font">
+		<test name="style"><string>Bold</string></test>
+		<edit name="hintstyle" mode="append"><const>hintnone</const></edit>
+		<edit name="lcdfilter" mode="append"><const>lcdnone</const></edit>
+	</match>
+	<match target="font">
+		<test name="style"><string>Italic</string></test>
+		<edit name="rgba" mode="assign"><const>hintfull</const></edit>
+		<edit name="hintstyle" mode="assign"><int>9</int></edit>
+		<edit name="weight" mode="assign"><int>200</int></edit>
+	</match>
+	<match target="font">
+		<test name="weight" compare="more"><int>100</int></test>
+		<edit name="hinting" mode="assign"><bool>false</bool></edit>
+	</match>
+	<match target="scan">
+		<edit name="hinting" mode="assign"><bool>false</bool></edit>
+	</match>
+</fontconfig>`,
+		"conf.d/10-bitmaps.conf": `<fontconfig>
+	<match target="font">
+		<edit name="embeddedbitmap" mode="assign"><bool>false</bool></edit>
+	</match>
+</fontconfig>`,
+	})
+	defer cleanup()
+
+	c, err := Load(filepath.Join(dir, "fonts.conf"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		c    *Config
+		p    Pattern
+		want Settings
+	}{
+		{
+			name: "nil",
+			c:    nil,
+			p:    Pattern{Family: "Go"},
+			want: defaultSettings,
+		},
+		{
+			name: "pattern edits",
+			c:    c,
+			p:    Pattern{Family: "Go", PixelSize: 16},
+			want: Settings{Hinting: true, HintStyle: HintSlight, Antialias: true, RGBA: RGBARGB, LCDFilter: freetype2.LCDFilterDefault},
+		},
+		{
+			name: "family test",
+			c:    c,
+			p:    Pattern{Family: "Go Mono", PixelSize: 16},
+			want: Settings{Hinting: false, HintStyle: HintSlight, Antialias: true, RGBA: RGBARGB, LCDFilter: freetype2.LCDFilterLight},
+		},
+		{
+			name: "size test",
+			c:    c,
+			p:    Pattern{Family: "Go", PixelSize: 10},
+			want: Settings{Hinting: true, HintStyle: HintSlight, Antialias: false, RGBA: RGBARGB, LCDFilter: freetype2.LCDFilterDefault},
+		},
+		{
+			name: "missing property",
+			c:    c,
+			p:    Pattern{Family: "Go"},
+			want: Settings{Hinting: true, HintStyle: HintSlight, Antialias: true, RGBA: RGBARGB, LCDFilter: freetype2.LCDFilterDefault},
+		},
+		{
+			name: "delete",
+			c:    c,
+			p:    Pattern{Family: "Gohu Bitmap"},
+			want: Settings{Hinting: true, HintStyle: HintSlight, Antialias: true, RGBA: RGBAUnknown, LCDFilter: freetype2.LCDFilterDefault, EmbeddedBitmap: true, Autohint: true},
+		},
+		{
+			name: "append",
+			c:    c,
+			p:    Pattern{Family: "Go", Style: "bold"},
+			want: Settings{Hinting: true, HintStyle: HintSlight, Antialias: true, RGBA: RGBARGB, LCDFilter: freetype2.LCDFilterDefault},
+		},
+		{
+			name: "invalid values",
+			c:    c,
+			p:    Pattern{Family: "Go", Style: "Italic"},
+			want: Settings{Hinting: true, HintStyle: HintFull, Antialias: true, RGBA: RGBARGB, LCDFilter: freetype2.LCDFilterDefault},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Settings(tt.p); got != tt.want {
+				t.Errorf("Config.Settings() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatch_apply(t *testing.T) {
+	m := match{
+		edits: []edit{
+			{property: "hintstyle", mode: "append", value: value{kind: kindNumber, n: float64(HintNone)}},
+			{property: "antialias", mode: "prepend", value: value{kind: kindBool, b: false}},
+		},
+	}
+
+	props := map[string]value{}
+	m.apply(props)
+	if got := props["hintstyle"]; got.n != float64(HintNone) {
+		t.Errorf("match.apply() hintstyle = %v, want %v", got.n, float64(HintNone))
+	}
+	if got := props["antialias"]; got.kind != kindBool || got.b {
+		t.Errorf("match.apply() antialias = %+v, want false", got)
+	}
+}
@@ -0,0 +1,584 @@
+package freetype2
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Slant is the slant of a face.
+type Slant int
+
+const (
+	// SlantNormal is used for upright faces.
+	SlantNormal Slant = iota
+	// SlantItalic is used for faces with cursive letterforms, designed to be
+	// italic.
+	SlantItalic
+	// SlantOblique is used for faces that are slanted versions of the upright
+	// ones.
+	SlantOblique
+)
+
+func (s Slant) String() string {
+	switch s {
+	case SlantNormal:
+		return "Normal"
+	case SlantItalic:
+		return "Italic"
+	case SlantOblique:
+		return "Oblique"
+	default:
+		return "Unknown"
+	}
+}
+
+// RuneRange is an inclusive range of runes.
+type RuneRange struct {
+	Lo, Hi rune
+}
+
+// Coverage is a set of runes, stored as sorted and non overlapping ranges.
+type Coverage []RuneRange
+
+// Has reports whether r is in the set.
+func (c Coverage) Has(r rune) bool {
+	i := sort.Search(len(c), func(i int) bool { return c[i].Hi >= r })
+	return i < len(c) && c[i].Lo <= r
+}
+
+// Len reports the number of runes in the set.
+func (c Coverage) Len() int {
+	n := 0
+	for _, rr := range c {
+		n += int(rr.Hi-rr.Lo) + 1
+	}
+	return n
+}
+
+// FontInfo describes a face found by a FontDB.
+type FontInfo struct {
+	// The path of the font file.
+	Path string
+	// The index of the face in the file, see Face.Index.
+	Index int
+	// The index of the named instance, starting at 1, or 0 for the default
+	// instance and for faces that are not variation fonts. See
+	// Face.NamedIndex.
+	NamedInstance int
+
+	Family         string
+	Style          string
+	PostscriptName string
+	// The weight, from 1 to 1000, 400 being normal and 700 bold. It is taken
+	// from the ‘OS/2’ table or the ‘wght’ axis, and guessed from the style
+	// name otherwise.
+	Weight int
+	// The width class, from 1 (ultra condensed) to 9 (ultra expanded), 5
+	// being normal. It is taken from the ‘OS/2’ table or the ‘wdth’ axis, and
+	// guessed from the style name otherwise.
+	Width int
+	Slant Slant
+
+	Format     FontFormat
+	Scalable   bool
+	FixedSizes []BitmapSize
+	// The runes mapped by the Unicode charmap of the face, empty if it has
+	// none.
+	Coverage Coverage
+}
+
+// Open opens the face described by i.
+func (i FontInfo) Open(l *Library) (*Face, error) {
+	return l.NewFaceFromPath(i.Path, i.Index, i.NamedInstance)
+}
+
+// fontExtensions lists the extensions of the files scanned by FontDB. WOFF2
+// is left out, FreeType only reads it since 2.10.2.
+var fontExtensions = map[string]bool{
+	".ttf": true, ".ttc": true, ".otf": true, ".otc": true,
+	".pfa": true, ".pfb": true, ".t1": true,
+	".bdf": true, ".pcf": true, ".fnt": true, ".fon": true,
+	".woff": true, ".pfr": true,
+}
+
+// isFontFile reports whether the extension of path is one of fontExtensions,
+// also allowing compressed bitmap fonts like ‘.pcf.gz’.
+func isFontFile(path string) bool {
+	path = strings.ToLower(path)
+	if ext := filepath.Ext(path); ext == ".gz" {
+		path = strings.TrimSuffix(path, ext)
+		ext = filepath.Ext(path)
+		return ext == ".pcf" || ext == ".bdf"
+	}
+	return fontExtensions[filepath.Ext(path)]
+}
+
+// fontFile is a font file indexed by a FontDB.
+type fontFile struct {
+	Path    string
+	Size    int64
+	ModTime int64
+	Fonts   []FontInfo
+}
+
+// FontDB is a database of the faces found in a set of directories, used to
+// find fonts without depending on fontconfig.
+//
+// The database can be saved to a cache file, so that only the files that
+// changed since have to be opened on the next Scan.
+type FontDB struct {
+	lib   *Library
+	files map[string]*fontFile
+}
+
+// NewFontDB creates an empty FontDB, using l to open the fonts.
+//
+// It returns ErrInvalidLibraryHandle if l is nil.
+func NewFontDB(l *Library) (*FontDB, error) {
+	if l == nil || l.ptr == nil {
+		return nil, ErrInvalidLibraryHandle
+	}
+
+	return &FontDB{
+		lib:   l,
+		files: make(map[string]*fontFile),
+	}, nil
+}
+
+// Scan indexes every face and named instance of the font files found in dirs
+// and their subdirectories. The symbolic links in the paths of dirs are
+// resolved, and the files are indexed under the resolved paths, but the links
+// found inside dirs are not followed.
+//
+// Files whose size and modification time match the ones already indexed are
+// not opened again, and files that disappeared from dirs are removed. Files
+// that are not fonts, or cannot be opened, are skipped.
+//
+// It returns the first error met reading dirs. The files that could be read
+// are indexed anyway, but no file is removed from a directory that couldn't be
+// read entirely, so that a transient failure doesn't empty the database.
+func (db *FontDB) Scan(dirs ...string) error {
+	if db == nil || db.lib == nil {
+		return ErrInvalidLibraryHandle
+	}
+
+	var ret error
+	seen := make(map[string]bool)
+	for _, dir := range dirs {
+		// filepath.Walk doesn't follow a root that is a link.
+		dir, err := filepath.EvalSymlinks(dir)
+		if err == nil {
+			err = db.scan(dir, seen)
+		}
+		if err != nil {
+			if ret == nil {
+				ret = err
+			}
+			continue
+		}
+
+		for path := range db.files {
+			if !seen[path] && inDir(path, dir) {
+				delete(db.files, path)
+			}
+		}
+	}
+	return ret
+}
+
+// walk is filepath.Walk, replaced by tests to fake read errors.
+var walk = filepath.Walk
+
+// scan indexes the font files found in dir, and adds them to seen. The walk
+// goes on after errors, the first one is returned.
+func (db *FontDB) scan(dir string, seen map[string]bool) error {
+	var ret error
+	err := walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if ret == nil {
+				ret = err
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() || !isFontFile(path) {
+			return nil
+		}
+
+		seen[path] = true
+		size, modTime := info.Size(), info.ModTime().UnixNano()
+		if f, ok := db.files[path]; ok && f.Size == size && f.ModTime == modTime {
+			return nil
+		}
+
+		fonts := db.index(path)
+		if len(fonts) == 0 {
+			delete(db.files, path)
+			return nil
+		}
+		db.files[path] = &fontFile{Path: path, Size: size, ModTime: modTime, Fonts: fonts}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return ret
+}
+
+// inDir reports whether path is inside dir.
+func inDir(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// index opens every face and named instance of the file at path.
+func (db *FontDB) index(path string) []FontInfo {
+	face, err := db.lib.NewFaceFromPath(path, 0, 0)
+	if err != nil {
+		return nil
+	}
+	numFaces := face.NumFaces()
+	face.Free()
+
+	var ret []FontInfo
+	for i := 0; i < numFaces; i++ {
+		face, err := db.lib.NewFaceFromPath(path, i, 0)
+		if err != nil {
+			continue
+		}
+		numInstances := face.NumNamedInstances()
+		ret = append(ret, newFontInfo(path, face))
+		face.Free()
+
+		for j := 1; j <= numInstances; j++ {
+			face, err := db.lib.NewFaceFromPath(path, i, j)
+			if err != nil {
+				continue
+			}
+			ret = append(ret, newFontInfo(path, face))
+			face.Free()
+		}
+	}
+	return ret
+}
+
+func newFontInfo(path string, f *Face) FontInfo {
+	weight, width, slant := staticAttributes(f)
+	info := FontInfo{
+		Path:           path,
+		Index:          f.Index(),
+		NamedInstance:  f.NamedIndex(),
+		Family:         f.FamilyName(),
+		Style:          f.StyleName(),
+		PostscriptName: f.PostscriptName(),
+		Weight:         weight,
+		Width:          width,
+		Slant:          slant,
+		Format:         f.FontFormat(),
+		Scalable:       f.HasFlag(FaceFlagScalable),
+		FixedSizes:     f.AvailableSizes(),
+		Coverage:       coverageOf(f),
+	}
+
+	if f.HasFlag(FaceFlagMultipleMasters) {
+		variationInfo(f, &info)
+	}
+	return info
+}
+
+// staticAttributes returns the weight, width class and slant of f, ignoring
+// its variation axes. They are taken from the ‘OS/2’ table, and guessed from
+// the style name and flags otherwise.
+func staticAttributes(f *Face) (weight, width int, slant Slant) {
+	style := f.StyleName()
+	weight, width, slant = weightFromStyle(style), widthFromStyle(style), SlantNormal
+
+	if f.HasStyle(StyleFlagBold) && weight < 700 {
+		weight = 700
+	}
+	if f.HasStyle(StyleFlagItalic) {
+		slant = SlantItalic
+	}
+	if strings.Contains(strings.ToLower(style), "oblique") {
+		slant = SlantOblique
+	}
+
+	if os2, err := f.OS2(); err == nil {
+		if os2.UsWeightClass >= 1 && os2.UsWeightClass <= 1000 {
+			weight = int(os2.UsWeightClass)
+		}
+		if os2.UsWidthClass >= 1 && os2.UsWidthClass <= 9 {
+			width = int(os2.UsWidthClass)
+		}
+		switch {
+		case os2.Version >= 4 && os2.FsSelection&(1<<9) != 0:
+			slant = SlantOblique
+		case os2.FsSelection&1 != 0:
+			slant = SlantItalic
+		}
+	}
+	return weight, width, slant
+}
+
+// variationInfo overrides the attributes of info with the coordinates of the
+// registered axes of a variation font.
+func variationInfo(f *Face, info *FontInfo) {
+	mm, err := f.MMVar()
+	if err != nil {
+		return
+	}
+	coords, err := f.VarDesignCoords()
+	if err != nil || len(coords) != len(mm.Axis) {
+		return
+	}
+
+	for i, axis := range mm.Axis {
+		v := coords[i].F64()
+		switch axis.Tag {
+		case VarAxisTagWght:
+			if v >= 1 && v <= 1000 {
+				info.Weight = int(v + 0.5)
+			}
+		case VarAxisTagWdth:
+			info.Width = widthClass(v)
+		case VarAxisTagItal:
+			if v >= 0.5 {
+				info.Slant = SlantItalic
+			}
+		case VarAxisTagSlnt:
+			if v != 0 && info.Slant == SlantNormal {
+				info.Slant = SlantOblique
+			}
+		}
+	}
+}
+
+// widthClasses maps the width classes to the percentages of the normal width
+// used by the ‘wdth’ axis.
+var widthClasses = [...]float64{50, 62.5, 75, 87.5, 100, 112.5, 125, 150, 200}
+
+// widthClass returns the width class closest to the percentage pct.
+func widthClass(pct float64) int {
+	best := 0
+	for i, w := range widthClasses {
+		if d, bd := w-pct, widthClasses[best]-pct; d*d < bd*bd {
+			best = i
+		}
+	}
+	return best + 1
+}
+
+// styleWeights and styleWidths map the words of style names to weights and
+// width classes. Longer words come first, so that ‘semibold’ is not taken
+// for ‘bold’.
+var (
+	styleWeights = []struct {
+		word   string
+		weight int
+	}{
+		{"extralight", 200}, {"ultralight", 200}, {"semibold", 600}, {"demibold", 600},
+		{"extrabold", 800}, {"ultrabold", 800}, {"hairline", 100}, {"medium", 500},
+		{"black", 900}, {"heavy", 900}, {"light", 300}, {"thin", 100}, {"bold", 700},
+	}
+	styleWidths = []struct {
+		word  string
+		width int
+	}{
+		{"ultracondensed", 1}, {"extracondensed", 2}, {"semicondensed", 4},
+		{"ultraexpanded", 9}, {"extraexpanded", 8}, {"semiexpanded", 6},
+		{"condensed", 3}, {"expanded", 7}, {"narrow", 3}, {"wide", 7},
+	}
+)
+
+// normalizeStyle lowercases a style name and removes its separators.
+func normalizeStyle(style string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(style))
+}
+
+// weightFromStyle guesses a weight from a style name, 400 if it has none.
+func weightFromStyle(style string) int {
+	style = normalizeStyle(style)
+	for _, w := range styleWeights {
+		if strings.Contains(style, w.word) {
+			return w.weight
+		}
+	}
+	return 400
+}
+
+// widthFromStyle guesses a width class from a style name, 5 if it has none.
+func widthFromStyle(style string) int {
+	style = normalizeStyle(style)
+	for _, w := range styleWidths {
+		if strings.Contains(style, w.word) {
+			return w.width
+		}
+	}
+	return 5
+}
+
+// coverageOf returns the runes mapped by the Unicode charmap of f.
+func coverageOf(f *Face) Coverage {
+	if cm, ok := f.ActiveCharMap(); !ok || cm.Encoding != EncodingUnicode {
+		if err := f.SelectCharMap(EncodingUnicode); err != nil {
+			return nil
+		}
+	}
+
+	var ret Coverage
+	for r, idx := f.FirstChar(); idx != 0; r, idx = f.NextChar(r) {
+		if n := len(ret); n > 0 && ret[n-1].Hi+1 == r {
+			ret[n-1].Hi = r
+			continue
+		}
+		ret = append(ret, RuneRange{Lo: r, Hi: r})
+	}
+	return ret
+}
+
+// Fonts returns every face in the database, sorted by path, face index and
+// named instance.
+func (db *FontDB) Fonts() []FontInfo {
+	if db == nil {
+		return nil
+	}
+
+	paths := make([]string, 0, len(db.files))
+	for path := range db.files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var ret []FontInfo
+	for _, path := range paths {
+		ret = append(ret, db.files[path].Fonts...)
+	}
+	return ret
+}
+
+// FontQuery selects faces of a FontDB. Zero fields match any face.
+type FontQuery struct {
+	// The family name, compared case insensitively.
+	Family string
+	// The style name, compared case insensitively.
+	Style string
+	// The PostScript name.
+	PostscriptName string
+	Weight         int
+	Width          int
+	Slant          *Slant
+	Format         FontFormat
+	// The runes that must all be covered.
+	Runes []rune
+}
+
+func (q FontQuery) matches(info FontInfo) bool {
+	switch {
+	case q.Family != "" && !strings.EqualFold(q.Family, info.Family):
+		return false
+	case q.Style != "" && !strings.EqualFold(q.Style, info.Style):
+		return false
+	case q.PostscriptName != "" && q.PostscriptName != info.PostscriptName:
+		return false
+	case q.Weight != 0 && q.Weight != info.Weight:
+		return false
+	case q.Width != 0 && q.Width != info.Width:
+		return false
+	case q.Slant != nil && *q.Slant != info.Slant:
+		return false
+	case q.Format != FontFormatNone && q.Format != info.Format:
+		return false
+	}
+
+	for _, r := range q.Runes {
+		if !info.Coverage.Has(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Query returns the faces matching q, in the order of Fonts.
+func (db *FontDB) Query(q FontQuery) []FontInfo {
+	var ret []FontInfo
+	for _, info := range db.Fonts() {
+		if q.matches(info) {
+			ret = append(ret, info)
+		}
+	}
+	return ret
+}
+
+// fontDBVersion is the version of the cache format, files with another
+// version are ignored by Load.
+const fontDBVersion = 1
+
+type fontDBCache struct {
+	Version int
+	Files   []*fontFile
+}
+
+// Save writes the database to w, to be restored with Load.
+func (db *FontDB) Save(w io.Writer) error {
+	if db == nil {
+		return ErrInvalidArgument
+	}
+
+	cache := fontDBCache{Version: fontDBVersion}
+	for _, f := range db.files {
+		cache.Files = append(cache.Files, f)
+	}
+	sort.Slice(cache.Files, func(i, j int) bool { return cache.Files[i].Path < cache.Files[j].Path })
+
+	return json.NewEncoder(w).Encode(cache)
+}
+
+// Load replaces the contents of the database with the ones written by Save.
+// The files are not checked, call Scan to bring the database up to date.
+//
+// It returns ErrInvalidArgument if r holds a cache of another version.
+func (db *FontDB) Load(r io.Reader) error {
+	if db == nil {
+		return ErrInvalidArgument
+	}
+
+	var cache fontDBCache
+	if err := json.NewDecoder(r).Decode(&cache); err != nil {
+		return err
+	}
+	if cache.Version != fontDBVersion {
+		return ErrInvalidArgument
+	}
+
+	db.files = make(map[string]*fontFile, len(cache.Files))
+	for _, f := range cache.Files {
+		db.files[f.Path] = f
+	}
+	return nil
+}
+
+// SaveFile writes the database to the cache file at path.
+func (db *FontDB) SaveFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := db.Save(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadFile restores the database from the cache file at path.
+func (db *FontDB) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return db.Load(f)
+}
@@ -0,0 +1,484 @@
+package freetype2
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func TestSlant_String(t *testing.T) {
+	tests := []struct {
+		name string
+		s    Slant
+		want string
+	}{
+		{name: "SlantNormal", s: SlantNormal, want: "Normal"},
+		{name: "SlantItalic", s: SlantItalic, want: "Italic"},
+		{name: "SlantOblique", s: SlantOblique, want: "Oblique"},
+		{name: "unknown", s: Slant(-1), want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.String(); got != tt.want {
+				t.Errorf("Slant.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	c := Coverage{{Lo: 'a', Hi: 'z'}, {Lo: 'é', Hi: 'é'}, {Lo: 0x1f600, Hi: 0x1f64f}}
+
+	tests := []struct {
+		r    rune
+		want bool
+	}{
+		{r: 'a', want: true},
+		{r: 'm', want: true},
+		{r: 'z', want: true},
+		{r: 'A', want: false},
+		{r: 'é', want: true},
+		{r: 'è', want: false},
+		{r: 0x1f64f, want: true},
+		{r: 0x1f650, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.r), func(t *testing.T) {
+			if got := c.Has(tt.r); got != tt.want {
+				t.Errorf("Coverage.Has(%U) = %v, want %v", tt.r, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := c.Len(), 26+1+80; got != want {
+		t.Errorf("Coverage.Len() = %v, want %v", got, want)
+	}
+	if got := Coverage(nil).Has('a'); got {
+		t.Errorf("Coverage.Has() on an empty set = %v, want false", got)
+	}
+}
+
+func Test_isFontFile(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "Go-Regular.ttf", want: true},
+		{path: "NotoSansJP-Thin.OTF", want: true},
+		{path: "fonts/collection.ttc", want: true},
+		{path: "NimbusMonoPS-Regular.pfa", want: true},
+		{path: "gohufont-11.pcf.gz", want: true},
+		{path: "bitout.fon", want: true},
+		{path: "font.woff", want: true},
+		{path: "font.woff2", want: false},
+		{path: "archive.tar.gz", want: false},
+		{path: "LICENSE.txt", want: false},
+		{path: "README", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := isFontFile(tt.path); got != tt.want {
+				t.Errorf("isFontFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_weightFromStyle(t *testing.T) {
+	tests := []struct {
+		style      string
+		wantWeight int
+		wantWidth  int
+	}{
+		{style: "", wantWeight: 400, wantWidth: 5},
+		{style: "Regular", wantWeight: 400, wantWidth: 5},
+		{style: "Bold Italic", wantWeight: 700, wantWidth: 5},
+		{style: "SemiBold", wantWeight: 600, wantWidth: 5},
+		{style: "Extra-Light Condensed", wantWeight: 200, wantWidth: 3},
+		{style: "Semi Condensed Black", wantWeight: 900, wantWidth: 4},
+		{style: "Thin Ultra Expanded", wantWeight: 100, wantWidth: 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			if got := weightFromStyle(tt.style); got != tt.wantWeight {
+				t.Errorf("weightFromStyle() = %v, want %v", got, tt.wantWeight)
+			}
+			if got := widthFromStyle(tt.style); got != tt.wantWidth {
+				t.Errorf("widthFromStyle() = %v, want %v", got, tt.wantWidth)
+			}
+		})
+	}
+}
+
+func Test_widthClass(t *testing.T) {
+	tests := []struct {
+		pct  float64
+		want int
+	}{
+		{pct: 0, want: 1},
+		{pct: 50, want: 1},
+		{pct: 85, want: 4},
+		{pct: 100, want: 5},
+		{pct: 125, want: 7},
+		{pct: 1000, want: 9},
+	}
+	for _, tt := range tests {
+		if got := widthClass(tt.pct); got != tt.want {
+			t.Errorf("widthClass(%v) = %v, want %v", tt.pct, got, tt.want)
+		}
+	}
+}
+
+func TestNewFontDB(t *testing.T) {
+	if _, err := NewFontDB(nil); err != ErrInvalidLibraryHandle {
+		t.Errorf("NewFontDB() error = %v, want %v", err, ErrInvalidLibraryHandle)
+	}
+
+	var db *FontDB
+	if err := db.Scan(testdata("go")); err != ErrInvalidLibraryHandle {
+		t.Errorf("FontDB.Scan() error = %v, want %v", err, ErrInvalidLibraryHandle)
+	}
+	if got := db.Fonts(); got != nil {
+		t.Errorf("FontDB.Fonts() = %v, want nil", got)
+	}
+	if err := db.Save(ioutil.Discard); err != ErrInvalidArgument {
+		t.Errorf("FontDB.Save() error = %v, want %v", err, ErrInvalidArgument)
+	}
+	if err := db.Load(bytes.NewReader(nil)); err != ErrInvalidArgument {
+		t.Errorf("FontDB.Load() error = %v, want %v", err, ErrInvalidArgument)
+	}
+}
+
+func openFontDB(t *testing.T, dirs ...string) (*FontDB, func()) {
+	t.Helper()
+
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to initialize library: %v", err)
+	}
+	db, err := NewFontDB(l)
+	if err != nil {
+		l.Free()
+		t.Fatalf("NewFontDB() error = %v", err)
+	}
+	if err := db.Scan(dirs...); err != nil {
+		l.Free()
+		t.Fatalf("FontDB.Scan() error = %v", err)
+	}
+	return db, func() { l.Free() }
+}
+
+func TestFontDB_Scan(t *testing.T) {
+	db, free := openFontDB(t, testdata("go"), testdata("gohu"), testdata("nimbus"))
+	defer free()
+
+	fonts := db.Fonts()
+	if got, want := len(fonts), 12+2+1; got != want {
+		t.Fatalf("len(FontDB.Fonts()) = %v, want %v", got, want)
+	}
+
+	byPath := make(map[string]FontInfo)
+	for _, info := range fonts {
+		byPath[filepath.Base(info.Path)] = info
+	}
+
+	tests := []struct {
+		file string
+		want FontInfo
+	}{
+		{
+			file: "Go-Regular.ttf",
+			want: FontInfo{
+				Path: testdata("go", "Go-Regular.ttf"), Family: "Go", Style: "Regular", PostscriptName: "GoRegular",
+				Weight: 400, Width: 5, Slant: SlantNormal, Format: FontFormatTrueType, Scalable: true,
+			},
+		},
+		{
+			file: "Go-Medium-Italic.ttf",
+			want: FontInfo{
+				Path: testdata("go", "Go-Medium-Italic.ttf"), Family: "Go Medium", Style: "Italic", PostscriptName: "GoMedium-Italic",
+				Weight: 500, Width: 5, Slant: SlantItalic, Format: FontFormatTrueType, Scalable: true,
+			},
+		},
+		{
+			file: "NimbusMonoPS-Regular.pfa",
+			want: FontInfo{
+				Path: testdata("nimbus", "NimbusMonoPS-Regular.pfa"), Family: "Nimbus Mono PS", Style: "Regular", PostscriptName: "NimbusMonoPS-Regular",
+				Weight: 400, Width: 5, Slant: SlantNormal, Format: FontFormatType1, Scalable: true,
+			},
+		},
+		{
+			file: "gohufont-11.bdf",
+			want: FontInfo{
+				Path: testdata("gohu", "gohufont-11.bdf"), Family: "GohuFont", Style: "Regular",
+				Weight: 400, Width: 5, Slant: SlantNormal, Format: FontFormatBDF, Scalable: false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			got, ok := byPath[tt.file]
+			if !ok {
+				t.Fatalf("%s not found", tt.file)
+			}
+
+			coverage, sizes := got.Coverage, got.FixedSizes
+			got.Coverage, got.FixedSizes = nil, nil
+			if diff := diff(got, tt.want); diff != nil {
+				t.Error(diff)
+			}
+
+			if !coverage.Has('a') || coverage.Has(0x10ffff) {
+				t.Errorf("unexpected coverage %v", coverage)
+			}
+			if tt.want.Scalable == (len(sizes) > 0) {
+				t.Errorf("FixedSizes = %v, scalable %v", sizes, tt.want.Scalable)
+			}
+		})
+	}
+}
+
+func TestFontDB_Scan_namedInstances(t *testing.T) {
+	db, free := openFontDB(t, testdata("variable", "mutatorSans"))
+	defer free()
+
+	face, err := faceFromPath("variable/mutatorSans/MutatorSans.ttf")()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+
+	fonts := db.Fonts()
+	if got, want := len(fonts), face.NumNamedInstances()+1; got != want {
+		t.Fatalf("len(FontDB.Fonts()) = %v, want %v", got, want)
+	}
+	for i, info := range fonts {
+		if info.NamedInstance != i {
+			t.Errorf("FontDB.Fonts()[%d].NamedInstance = %v, want %v", i, info.NamedInstance, i)
+		}
+	}
+
+	bold := db.Query(FontQuery{Style: "BoldCondensed"})
+	if len(bold) != 1 || bold[0].Weight <= fonts[0].Weight {
+		t.Fatalf("FontDB.Query() = %v, want a single bolder instance", bold)
+	}
+
+	f, err := bold[0].Open(face.l)
+	if err != nil {
+		t.Fatalf("FontInfo.Open() error = %v", err)
+	}
+	defer f.Free()
+	if got := f.StyleName(); got != "BoldCondensed" {
+		t.Errorf("FontInfo.Open() style = %v, want BoldCondensed", got)
+	}
+}
+
+func TestFontDB_Query(t *testing.T) {
+	db, free := openFontDB(t, testdata("go"), testdata("gohu"), testdata("noto sans jp"))
+	defer free()
+
+	italic := SlantItalic
+	tests := []struct {
+		name  string
+		query FontQuery
+		want  []string
+	}{
+		{name: "case insensitive", query: FontQuery{Family: "gohufont"}, want: []string{"gohufont-11.bdf", "gohufont-11.pcf"}},
+		{name: "family", query: FontQuery{Family: "go mono"}, want: []string{"Go-Mono-Bold-Italic.ttf", "Go-Mono-Bold.ttf", "Go-Mono-Italic.ttf", "Go-Mono.ttf"}},
+		{name: "style", query: FontQuery{Family: "Go", Style: "bold italic"}, want: []string{"Go-Bold-Italic.ttf"}},
+		{name: "slant", query: FontQuery{Family: "Go", Slant: &italic}, want: []string{"Go-Bold-Italic.ttf", "Go-Italic.ttf"}},
+		{name: "weight", query: FontQuery{Weight: 500}, want: []string{"Go-Medium-Italic.ttf", "Go-Medium.ttf"}},
+		{name: "postscript name", query: FontQuery{PostscriptName: "GoSmallcaps"}, want: []string{"Go-Smallcaps.ttf"}},
+		{name: "format", query: FontQuery{Format: FontFormatPCF}, want: []string{"gohufont-11.pcf"}},
+		{name: "runes", query: FontQuery{Runes: []rune("日本")}, want: []string{"NotoSansJP-Thin.otf"}},
+		{name: "runes and family", query: FontQuery{Family: "Go", Runes: []rune("日本")}, want: nil},
+		{name: "no match", query: FontQuery{Family: "Helvetica"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, info := range db.Query(tt.query) {
+				got = append(got, filepath.Base(info.Path))
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestFontDB_cache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fontdb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	data, err := ioutil.ReadFile(testdata("go", "Go-Regular.ttf"))
+	if err != nil {
+		t.Fatalf("unable to read font: %v", err)
+	}
+	fontPath := filepath.Join(dir, "fonts", "Go-Regular.ttf")
+	if err := os.MkdirAll(filepath.Dir(fontPath), 0755); err != nil {
+		t.Fatalf("unable to create dir: %v", err)
+	}
+	if err := ioutil.WriteFile(fontPath, data, 0644); err != nil {
+		t.Fatalf("unable to write font: %v", err)
+	}
+
+	db, free := openFontDB(t, filepath.Join(dir, "fonts"), testdata("gohu"))
+	defer free()
+	want := db.Fonts()
+
+	cachePath := filepath.Join(dir, "fonts.cache")
+	if err := db.SaveFile(cachePath); err != nil {
+		t.Fatalf("FontDB.SaveFile() error = %v", err)
+	}
+
+	loaded, err := NewFontDB(db.lib)
+	if err != nil {
+		t.Fatalf("NewFontDB() error = %v", err)
+	}
+	if err := loaded.LoadFile(cachePath); err != nil {
+		t.Fatalf("FontDB.LoadFile() error = %v", err)
+	}
+	if diff := diff(loaded.Fonts(), want); diff != nil {
+		t.Fatal(diff)
+	}
+
+	// unchanged files are not opened again.
+	loaded.files[fontPath].Fonts[0].Family = "cached"
+	if err := loaded.Scan(filepath.Join(dir, "fonts")); err != nil {
+		t.Fatalf("FontDB.Scan() error = %v", err)
+	}
+	if got := loaded.Query(FontQuery{Family: "cached"}); len(got) != 1 {
+		t.Errorf("FontDB.Scan() reopened an unchanged file")
+	}
+
+	// removed files are dropped, files in other directories are kept.
+	if err := os.Remove(fontPath); err != nil {
+		t.Fatalf("unable to remove font: %v", err)
+	}
+	if err := loaded.Scan(filepath.Join(dir, "fonts")); err != nil {
+		t.Fatalf("FontDB.Scan() error = %v", err)
+	}
+	if got := loaded.Fonts(); len(got) != 2 || got[0].Family != "GohuFont" {
+		t.Errorf("FontDB.Fonts() = %v, want the gohu fonts only", got)
+	}
+
+	if err := loaded.Load(bytes.NewReader([]byte(`{"Version": 0}`))); err != ErrInvalidArgument {
+		t.Errorf("FontDB.Load() error = %v, want %v", err, ErrInvalidArgument)
+	}
+	if err := loaded.Scan(filepath.Join(dir, "missing")); err == nil {
+		t.Errorf("FontDB.Scan() of a missing directory should fail")
+	}
+}
+
+func TestFontDB_Scan_readError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fontdb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if dir, err = filepath.EvalSymlinks(dir); err != nil {
+		t.Fatalf("unable to resolve temp dir: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(testdata("go", "Go-Regular.ttf"))
+	if err != nil {
+		t.Fatalf("unable to read font: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "Go-Regular.ttf"), data, 0644); err != nil {
+		t.Fatalf("unable to write font: %v", err)
+	}
+
+	db, free := openFontDB(t, dir)
+	defer free()
+
+	// dir exists, but can't be listed. Removing its permissions wouldn't do,
+	// as they are ignored when running as root.
+	errRead := errors.New("read error")
+	defer func() { walk = filepath.Walk }()
+	walk = func(root string, fn filepath.WalkFunc) error {
+		if root != dir {
+			return filepath.Walk(root, fn)
+		}
+		info, err := os.Lstat(root)
+		if err != nil {
+			return err
+		}
+		return fn(root, info, errRead)
+	}
+
+	if err := db.Scan(dir, testdata("gohu")); err != errRead {
+		t.Errorf("FontDB.Scan() error = %v, want %v", err, errRead)
+	}
+
+	// the fonts of dir are kept, the other directories are still scanned.
+	var got []string
+	for _, info := range db.Fonts() {
+		got = append(got, filepath.Base(info.Path))
+	}
+	sort.Strings(got)
+	want := []string{"Go-Regular.ttf", "gohufont-11.bdf", "gohufont-11.pcf"}
+	if diff := diff(got, want); diff != nil {
+		t.Error(diff)
+	}
+}
+
+func TestFontDB_Scan_symlinks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fontdb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if dir, err = filepath.EvalSymlinks(dir); err != nil {
+		t.Fatalf("unable to resolve temp dir: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(testdata("go", "Go-Regular.ttf"))
+	if err != nil {
+		t.Fatalf("unable to read font: %v", err)
+	}
+	for _, name := range []string{"fonts/Go-Regular.ttf", "other/Go-Regular.ttf"} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unable to create dir: %v", err)
+		}
+		if err := ioutil.WriteFile(path, data, 0644); err != nil {
+			t.Fatalf("unable to write font: %v", err)
+		}
+	}
+
+	// the root is a link, the link to other inside it is not followed.
+	if err := os.Symlink(filepath.Join(dir, "fonts"), filepath.Join(dir, "link")); err != nil {
+		t.Fatalf("unable to create link: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(dir, "other"), filepath.Join(dir, "fonts", "other")); err != nil {
+		t.Fatalf("unable to create link: %v", err)
+	}
+
+	db, free := openFontDB(t, filepath.Join(dir, "link"))
+	defer free()
+
+	var got []string
+	for _, info := range db.Fonts() {
+		got = append(got, info.Path)
+	}
+	want := []string{filepath.Join(dir, "fonts", "Go-Regular.ttf")}
+	if diff := diff(got, want); diff != nil {
+		t.Error(diff)
+	}
+
+	// scanning the link again keeps the fonts of the directory it points to.
+	if err := db.Scan(filepath.Join(dir, "link")); err != nil {
+		t.Fatalf("FontDB.Scan() error = %v", err)
+	}
+	if got := db.Fonts(); len(got) != 1 {
+		t.Errorf("FontDB.Fonts() = %v, want 1 font", got)
+	}
+}
@@ -0,0 +1,394 @@
+package freetype2
+
+import (
+	"strings"
+
+	"github.com/flga/freetype2/fixed"
+)
+
+// FontRequest describes the face wanted by a CSS font declaration.
+type FontRequest struct {
+	// The font-family list, in order of preference. Names are compared case
+	// insensitively. If empty, all the faces are considered to be of the same
+	// family.
+	Families []string
+	// The font-weight, from 1 to 1000. Zero means 400, normal.
+	Weight float64
+	// The font-stretch, as a percentage of the normal width. Zero means 100%,
+	// normal.
+	Stretch float64
+	// The font-style.
+	Style Slant
+	// The angle of the oblique font-style, in degrees, between -90 and 90.
+	// Positive angles slant clockwise. Zero means 14, the CSS default; use
+	// SlantNormal for upright text.
+	ObliqueAngle float64
+}
+
+// defaultObliqueAngle is the angle of ‘font-style: oblique’, in degrees.
+const defaultObliqueAngle = 14
+
+// MatchFace selects the face of faces matching req, following the font
+// matching algorithm of CSS Fonts Level 4.
+//
+// The first family of req having faces is used. Its faces are narrowed by
+// font-stretch, then by font-style, where italic falls back to oblique and
+// then to normal faces, and finally by font-weight, with the special cases of
+// the weights between 400 and 500. Ties go to the first face.
+//
+// Variation fonts match any value in the range of their ‘wght’, ‘wdth’, ‘ital’
+// and ‘slnt’ axes. The coordinates of the selected face are set to the
+// matched values with SetVarDesignCoords, keeping its other axes.
+//
+// It returns nil and no error if none of the families are found. It returns
+// ErrInvalidArgument if req is out of range, and ErrInvalidFaceHandle if any
+// of faces is nil.
+//
+// See https://www.w3.org/TR/css-fonts-4/#font-matching-algorithm
+func MatchFace(faces []*Face, req FontRequest) (*Face, error) {
+	if req.Weight == 0 {
+		req.Weight = 400
+	}
+	if req.Stretch == 0 {
+		req.Stretch = 100
+	}
+	if req.Style == SlantOblique && req.ObliqueAngle == 0 {
+		req.ObliqueAngle = defaultObliqueAngle
+	}
+	switch {
+	case req.Weight < 1 || req.Weight > 1000:
+		return nil, ErrInvalidArgument
+	case req.Stretch < 0:
+		return nil, ErrInvalidArgument
+	case req.Style < SlantNormal || req.Style > SlantOblique:
+		return nil, ErrInvalidArgument
+	case req.ObliqueAngle <= -90 || req.ObliqueAngle >= 90:
+		return nil, ErrInvalidArgument
+	}
+
+	for _, f := range faces {
+		if f == nil || f.ptr == nil {
+			return nil, ErrInvalidFaceHandle
+		}
+	}
+
+	families := req.Families
+	if len(families) == 0 {
+		families = []string{""}
+	}
+	for _, family := range families {
+		var candidates []*matchCandidate
+		for _, f := range faces {
+			if family == "" || strings.EqualFold(f.FamilyName(), family) {
+				candidates = append(candidates, describeFace(f))
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		candidates = narrow(candidates, func(c *matchCandidate) matchKey {
+			c.stretch = clamp(req.Stretch, c.stretchRange)
+			return stretchKey(c.stretch, req.Stretch)
+		})
+		candidates = narrow(candidates, func(c *matchCandidate) matchKey {
+			return c.matchStyle(req.Style, req.ObliqueAngle)
+		})
+		candidates = narrow(candidates, func(c *matchCandidate) matchKey {
+			c.weight = clamp(req.Weight, c.weightRange)
+			return weightKey(c.weight, req.Weight)
+		})
+
+		best := candidates[0]
+		return best.face, best.apply()
+	}
+	return nil, nil
+}
+
+// matchCandidate holds the ranges of values a face can match, and the values
+// chosen for it so far.
+type matchCandidate struct {
+	face *Face
+
+	weightRange  [2]float64
+	stretchRange [2]float64
+	// whether the face can be upright, and the range of its oblique angles
+	// when it is. Normal faces have an angle of 0.
+	upright    bool
+	angleRange [2]float64
+	// whether the face can be italic.
+	italic bool
+
+	// the axes and coordinates of variation fonts.
+	axes   []VarAxis
+	coords []fixed.Int16_16
+
+	weight, stretch float64
+	angle           float64
+	isItalic        bool
+}
+
+// describeFace returns the ranges of values f can match. They are taken from
+// the registered axes of variation fonts, and from the static attributes of
+// the face otherwise.
+func describeFace(f *Face) *matchCandidate {
+	weight, width, slant := staticAttributes(f)
+	c := &matchCandidate{
+		face:         f,
+		weightRange:  [2]float64{float64(weight), float64(weight)},
+		stretchRange: [2]float64{widthClasses[width-1], widthClasses[width-1]},
+	}
+
+	switch slant {
+	case SlantItalic:
+		c.italic = true
+	case SlantOblique:
+		a := obliqueAngle(f)
+		c.upright, c.angleRange = true, [2]float64{a, a}
+	default:
+		c.upright = true
+	}
+
+	// the axes of Adobe MM fonts are not in design units.
+	if !f.HasFlag(FaceFlagMultipleMasters) || !f.HasFlag(FaceFlagSfnt) {
+		return c
+	}
+	mm, err := f.MMVar()
+	if err != nil {
+		return c
+	}
+	coords, err := f.VarDesignCoords()
+	if err != nil || len(coords) != len(mm.Axis) {
+		return c
+	}
+
+	c.axes, c.coords = mm.Axis, coords
+	for _, axis := range mm.Axis {
+		lo, hi := axis.Min.F64(), axis.Max.F64()
+		switch axis.Tag {
+		case VarAxisTagWght:
+			c.weightRange = [2]float64{lo, hi}
+		case VarAxisTagWdth:
+			c.stretchRange = [2]float64{lo, hi}
+		case VarAxisTagSlnt:
+			// positive ‘slnt’ values slant counter clockwise.
+			c.angleRange = [2]float64{-hi, -lo}
+		case VarAxisTagItal:
+			c.upright, c.italic = lo < 1, hi >= 1
+		}
+	}
+	return c
+}
+
+// obliqueAngle returns the angle of an oblique face, from its ‘post’ table,
+// or the CSS default if it has none.
+func obliqueAngle(f *Face) float64 {
+	if post, err := f.Postscript(); err == nil && post.ItalicAngle != 0 {
+		return -post.ItalicAngle.F64()
+	}
+	return defaultObliqueAngle
+}
+
+// matchStyle chooses whether c is italic, or its oblique angle, for the
+// requested style.
+func (c *matchCandidate) matchStyle(style Slant, angle float64) matchKey {
+	var best matchKey
+	found := false
+	try := func(italic bool, v float64) {
+		if k := styleKey(italic, v, style, angle); !found || k.less(best) {
+			best, found = k, true
+			c.isItalic, c.angle = italic, v
+		}
+	}
+
+	if c.italic {
+		try(true, 0)
+	}
+	if c.upright {
+		for _, v := range []float64{angle, 0, 11, -11, defaultObliqueAngle, c.angleRange[0], c.angleRange[1]} {
+			try(false, clamp(v, c.angleRange))
+		}
+	}
+	return best
+}
+
+// apply sets the coordinates of a variation font to the chosen values.
+func (c *matchCandidate) apply() error {
+	if c.axes == nil {
+		return nil
+	}
+
+	coords := append([]fixed.Int16_16(nil), c.coords...)
+	for i, axis := range c.axes {
+		var v float64
+		switch axis.Tag {
+		case VarAxisTagWght:
+			v = c.weight
+		case VarAxisTagWdth:
+			v = c.stretch
+		case VarAxisTagSlnt:
+			v = -c.angle
+		case VarAxisTagItal:
+			if c.isItalic {
+				v = 1
+			}
+		default:
+			continue
+		}
+		coords[i] = fixed.Int16_16(clamp(v, [2]float64{axis.Min.F64(), axis.Max.F64()}) * 65536)
+	}
+	return c.face.SetVarDesignCoords(coords)
+}
+
+// matchKey orders the values a face can match, lower groups come first and,
+// within a group, the closest values to the requested one.
+type matchKey struct {
+	group int
+	dist  float64
+}
+
+func (k matchKey) less(o matchKey) bool {
+	return k.group < o.group || k.group == o.group && k.dist < o.dist
+}
+
+// narrow keeps the candidates with the best key.
+func narrow(candidates []*matchCandidate, key func(c *matchCandidate) matchKey) []*matchCandidate {
+	keys := make([]matchKey, len(candidates))
+	best := 0
+	for i, c := range candidates {
+		keys[i] = key(c)
+		if keys[i].less(keys[best]) {
+			best = i
+		}
+	}
+
+	var ret []*matchCandidate
+	for i, c := range candidates {
+		if keys[i] == keys[best] {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+func clamp(v float64, r [2]float64) float64 {
+	if v < r[0] {
+		return r[0]
+	}
+	if v > r[1] {
+		return r[1]
+	}
+	return v
+}
+
+// stretchKey orders the widths v for the requested want: narrower widths
+// first when want is condensed or normal, wider widths first otherwise.
+func stretchKey(v, want float64) matchKey {
+	if want <= 100 {
+		if v <= want {
+			return matchKey{0, want - v}
+		}
+		return matchKey{1, v - want}
+	}
+	if v >= want {
+		return matchKey{0, v - want}
+	}
+	return matchKey{1, want - v}
+}
+
+// weightKey orders the weights v for the requested want. Weights between want
+// and 500 come first when want is between 400 and 500, then lighter weights,
+// then heavier ones. Lighter weights come first below 400, heavier ones above
+// 500.
+func weightKey(v, want float64) matchKey {
+	switch {
+	case want >= 400 && want <= 500:
+		switch {
+		case v >= want && v <= 500:
+			return matchKey{0, v - want}
+		case v < want:
+			return matchKey{1, want - v}
+		default:
+			return matchKey{2, v - want}
+		}
+	case want < 400:
+		if v <= want {
+			return matchKey{0, want - v}
+		}
+		return matchKey{1, v - want}
+	default:
+		if v >= want {
+			return matchKey{0, v - want}
+		}
+		return matchKey{1, want - v}
+	}
+}
+
+// styleKey orders the italic faces and the oblique angles v of upright faces
+// for the requested style and angle.
+//
+// Normal requests prefer normal faces, then oblique ones, then italic ones.
+// Italic requests prefer italic faces, then oblique ones, then normal ones.
+// Oblique requests prefer oblique faces slanted in the same direction, then
+// italic ones, then normal ones.
+func styleKey(italic bool, v float64, style Slant, angle float64) matchKey {
+	switch style {
+	case SlantItalic:
+		switch {
+		case italic:
+			return matchKey{0, 0}
+		case v > 0:
+			k := obliqueKey(v, defaultObliqueAngle)
+			return matchKey{k.group + 1, k.dist}
+		case v < 0:
+			return matchKey{4, -v}
+		default:
+			return matchKey{5, 0}
+		}
+	case SlantOblique:
+		if angle < 0 {
+			v, angle = -v, -angle
+		}
+		switch {
+		case italic:
+			return matchKey{3, 0}
+		case v > 0:
+			return obliqueKey(v, angle)
+		case v == 0:
+			return matchKey{4, 0}
+		default:
+			return matchKey{5, -v}
+		}
+	default:
+		switch {
+		case italic:
+			return matchKey{3, 0}
+		case v == 0:
+			return matchKey{0, 0}
+		case v > 0:
+			return matchKey{1, v}
+		default:
+			return matchKey{2, -v}
+		}
+	}
+}
+
+// obliqueKey orders the positive oblique angles v for the requested angle. At
+// 11 degrees or more, steeper angles come first, then shallower ones. Below,
+// angles up to 11 degrees come first, then shallower ones, then steeper ones.
+func obliqueKey(v, angle float64) matchKey {
+	if angle >= 11 {
+		if v >= angle {
+			return matchKey{0, v - angle}
+		}
+		return matchKey{1, angle - v}
+	}
+	switch {
+	case v >= angle && v <= 11:
+		return matchKey{0, v - angle}
+	case v < angle:
+		return matchKey{1, angle - v}
+	default:
+		return matchKey{2, v - angle}
+	}
+}
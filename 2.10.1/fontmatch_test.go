@@ -0,0 +1,215 @@
+package freetype2
+
+import (
+	"testing"
+
+	"github.com/flga/freetype2/fixed"
+)
+
+func TestMatchFace(t *testing.T) {
+	set, faces, free := openFontSet(t, goRegular, goBold, goItalic, goBoldItalic, goMono)
+	defer free()
+	all := set.faces
+
+	regular, bold, italic, boldItalic, mono := faces[0].Face, faces[1].Face, faces[2].Face, faces[3].Face, faces[4].Face
+
+	tests := []struct {
+		name    string
+		req     FontRequest
+		want    *Face
+		wantErr error
+	}{
+		{name: "defaults", req: FontRequest{Families: []string{"Go"}}, want: regular},
+		{name: "no families", req: FontRequest{}, want: regular},
+		{name: "case insensitive", req: FontRequest{Families: []string{"go mono"}}, want: mono},
+		{name: "fallback family", req: FontRequest{Families: []string{"Helvetica", "Go Mono", "Go"}}, want: mono},
+		{name: "missing family", req: FontRequest{Families: []string{"Helvetica"}}, want: nil},
+
+		// Go Bold has a weight of 600.
+		{name: "exact weight", req: FontRequest{Families: []string{"Go"}, Weight: 600}, want: bold},
+		{name: "bold", req: FontRequest{Families: []string{"Go"}, Weight: 700}, want: bold},
+		{name: "heavier than all", req: FontRequest{Families: []string{"Go"}, Weight: 900}, want: bold},
+		{name: "light", req: FontRequest{Families: []string{"Go"}, Weight: 300}, want: regular},
+		{name: "between 400 and 500", req: FontRequest{Families: []string{"Go"}, Weight: 450}, want: regular},
+		{name: "above 500", req: FontRequest{Families: []string{"Go"}, Weight: 550}, want: bold},
+
+		{name: "italic", req: FontRequest{Families: []string{"Go"}, Style: SlantItalic}, want: italic},
+		{name: "bold italic", req: FontRequest{Families: []string{"Go"}, Style: SlantItalic, Weight: 700}, want: boldItalic},
+		{name: "oblique falls back to italic", req: FontRequest{Families: []string{"Go"}, Style: SlantOblique}, want: italic},
+		{name: "italic falls back to normal", req: FontRequest{Families: []string{"Go Mono"}, Style: SlantItalic}, want: mono},
+
+		{name: "bad weight", req: FontRequest{Weight: 1001}, wantErr: ErrInvalidArgument},
+		{name: "bad stretch", req: FontRequest{Stretch: -1}, wantErr: ErrInvalidArgument},
+		{name: "bad style", req: FontRequest{Style: Slant(-1)}, wantErr: ErrInvalidArgument},
+		{name: "bad angle", req: FontRequest{Style: SlantOblique, ObliqueAngle: 90}, wantErr: ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchFace(all, tt.req)
+			if err != tt.wantErr {
+				t.Fatalf("MatchFace() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("MatchFace() = %s %s, want %s %s", got.FamilyName(), got.StyleName(), tt.want.FamilyName(), tt.want.StyleName())
+			}
+		})
+	}
+
+	if _, err := MatchFace([]*Face{regular, nil}, FontRequest{}); err != ErrInvalidFaceHandle {
+		t.Errorf("MatchFace() error = %v, want %v", err, ErrInvalidFaceHandle)
+	}
+}
+
+func TestMatchFace_variations(t *testing.T) {
+	_, faces, free := openFontSet(t,
+		faceFromPath("variable/IBM-Plex-Sans-Variable/IBMPlexSansVar-Roman.ttf"),
+		faceFromPath("variable/IBM-Plex-Sans-Variable/IBMPlexSansVar-Italic.ttf"),
+		faceFromPath("variable/sudo-font/SudoVariable.ttf"),
+		faceFromPath("variable/soulcraft/Soulcraft.ttf"),
+	)
+	defer free()
+	all := []*Face{faces[0].Face, faces[1].Face, faces[2].Face, faces[3].Face}
+
+	tests := []struct {
+		name       string
+		req        FontRequest
+		want       int
+		wantCoords []float64
+	}{
+		{name: "default", req: FontRequest{Families: []string{"IBM Plex Sans Var"}}, want: 0, wantCoords: []float64{400, 100}},
+		{name: "weight", req: FontRequest{Families: []string{"IBM Plex Sans Var"}, Weight: 250}, want: 0, wantCoords: []float64{250, 100}},
+		{name: "weight above range", req: FontRequest{Families: []string{"IBM Plex Sans Var"}, Weight: 900}, want: 0, wantCoords: []float64{700, 100}},
+		{name: "stretch", req: FontRequest{Families: []string{"IBM Plex Sans Var"}, Stretch: 90}, want: 0, wantCoords: []float64{400, 90}},
+		{name: "stretch below range", req: FontRequest{Families: []string{"IBM Plex Sans Var"}, Stretch: 50}, want: 0, wantCoords: []float64{400, 85}},
+		{
+			name:       "italic face",
+			req:        FontRequest{Families: []string{"IBM Plex Sans Var"}, Style: SlantItalic, Weight: 650},
+			want:       1,
+			wantCoords: []float64{650, 100},
+		},
+		{name: "ital axis", req: FontRequest{Families: []string{"Sudo"}, Style: SlantItalic, Weight: 300}, want: 2, wantCoords: []float64{1, 300}},
+		{name: "ital axis upright", req: FontRequest{Families: []string{"Sudo"}, Weight: 600}, want: 2, wantCoords: []float64{0, 600}},
+		{name: "slnt axis", req: FontRequest{Families: []string{"Soulcraft"}, Style: SlantOblique, ObliqueAngle: -20}, want: 3, wantCoords: []float64{100, 20}},
+		{name: "slnt axis normal", req: FontRequest{Families: []string{"Soulcraft"}, Style: SlantOblique}, want: 3, wantCoords: []float64{100, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchFace(all, tt.req)
+			if err != nil {
+				t.Fatalf("MatchFace() error = %v", err)
+			}
+			if got != all[tt.want] {
+				t.Fatalf("MatchFace() = %s %s, want %s %s", got.FamilyName(), got.StyleName(), all[tt.want].FamilyName(), all[tt.want].StyleName())
+			}
+
+			coords, err := got.VarDesignCoords()
+			if err != nil {
+				t.Fatalf("Face.VarDesignCoords() error = %v", err)
+			}
+			var want []fixed.Int16_16
+			for _, v := range tt.wantCoords {
+				want = append(want, fixed.Int16_16(v*65536))
+			}
+			if diff := diff(coords, want); diff != nil {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_weightKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    float64
+		weights []float64
+		best    float64
+	}{
+		{name: "exact", want: 400, weights: []float64{300, 400, 500}, best: 400},
+		{name: "400 prefers 500 over lighter", want: 400, weights: []float64{300, 500}, best: 500},
+		{name: "450 prefers up to 500", want: 450, weights: []float64{400, 500}, best: 500},
+		{name: "450 prefers lighter over above 500", want: 450, weights: []float64{300, 600}, best: 300},
+		{name: "500 prefers lighter", want: 500, weights: []float64{400, 600}, best: 400},
+		{name: "300 prefers lighter", want: 300, weights: []float64{100, 400}, best: 100},
+		{name: "300 falls back to heavier", want: 300, weights: []float64{400, 900}, best: 400},
+		{name: "600 prefers heavier", want: 600, weights: []float64{500, 900}, best: 900},
+		{name: "600 falls back to lighter", want: 600, weights: []float64{100, 500}, best: 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			best := tt.weights[0]
+			for _, w := range tt.weights[1:] {
+				if weightKey(w, tt.want).less(weightKey(best, tt.want)) {
+					best = w
+				}
+			}
+			if best != tt.best {
+				t.Errorf("best weight = %v, want %v", best, tt.best)
+			}
+		})
+	}
+}
+
+func Test_stretchKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   float64
+		widths []float64
+		best   float64
+	}{
+		{name: "exact", want: 100, widths: []float64{75, 100, 125}, best: 100},
+		{name: "normal prefers narrower", want: 100, widths: []float64{87.5, 112.5}, best: 87.5},
+		{name: "normal falls back to wider", want: 100, widths: []float64{112.5, 150}, best: 112.5},
+		{name: "expanded prefers wider", want: 125, widths: []float64{112.5, 200}, best: 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			best := tt.widths[0]
+			for _, w := range tt.widths[1:] {
+				if stretchKey(w, tt.want).less(stretchKey(best, tt.want)) {
+					best = w
+				}
+			}
+			if best != tt.best {
+				t.Errorf("best width = %v, want %v", best, tt.best)
+			}
+		})
+	}
+}
+
+func Test_styleKey(t *testing.T) {
+	type face struct {
+		italic bool
+		angle  float64
+	}
+	tests := []struct {
+		name  string
+		style Slant
+		angle float64
+		faces []face
+		best  face
+	}{
+		{name: "normal", style: SlantNormal, faces: []face{{true, 0}, {false, 14}, {false, 0}}, best: face{false, 0}},
+		{name: "normal prefers oblique", style: SlantNormal, faces: []face{{true, 0}, {false, 14}}, best: face{false, 14}},
+		{name: "italic", style: SlantItalic, faces: []face{{false, 0}, {false, 14}, {true, 0}}, best: face{true, 0}},
+		{name: "italic prefers oblique", style: SlantItalic, faces: []face{{false, 0}, {false, 14}}, best: face{false, 14}},
+		{name: "oblique", style: SlantOblique, angle: 14, faces: []face{{true, 0}, {false, 10}, {false, 20}}, best: face{false, 20}},
+		{name: "oblique shallower", style: SlantOblique, angle: 14, faces: []face{{true, 0}, {false, 10}, {false, 5}}, best: face{false, 10}},
+		{name: "oblique prefers italic", style: SlantOblique, angle: 14, faces: []face{{false, 0}, {true, 0}}, best: face{true, 0}},
+		{name: "small oblique up to 11", style: SlantOblique, angle: 5, faces: []face{{false, 4}, {false, 10}, {false, 20}}, best: face{false, 10}},
+		{name: "small oblique shallower", style: SlantOblique, angle: 5, faces: []face{{false, 4}, {false, 20}}, best: face{false, 4}},
+		{name: "negative oblique", style: SlantOblique, angle: -14, faces: []face{{false, 14}, {false, -20}}, best: face{false, -20}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			best := tt.faces[0]
+			for _, f := range tt.faces[1:] {
+				if styleKey(f.italic, f.angle, tt.style, tt.angle).less(styleKey(best.italic, best.angle, tt.style, tt.angle)) {
+					best = f
+				}
+			}
+			if best != tt.best {
+				t.Errorf("best face = %v, want %v", best, tt.best)
+			}
+		})
+	}
+}
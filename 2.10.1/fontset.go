@@ -0,0 +1,279 @@
+package freetype2
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/flga/freetype2/fixed"
+)
+
+// A FontSet is an ordered list of faces used together to render text that no
+// single face covers, such as latin text mixed with ideographs and emoji.
+//
+// Every rune is rendered with the first face that has a glyph for it, so the
+// primary face, the one used for most of the text, comes first, followed by
+// the fallbacks in order of preference.
+type FontSet struct {
+	faces []*Face
+	// the factor to apply to the glyphs of each face, see Scale.
+	scales []float64
+}
+
+// NewFontSet creates a FontSet out of faces, in order of preference. The set
+// does not take ownership of the faces, which must outlive it.
+//
+// It returns ErrInvalidArgument if no face is given, and ErrInvalidFaceHandle
+// if any of them is nil.
+func NewFontSet(faces ...*Face) (*FontSet, error) {
+	if len(faces) == 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	scales := make([]float64, len(faces))
+	for i, f := range faces {
+		if f == nil || f.ptr == nil {
+			return nil, ErrInvalidFaceHandle
+		}
+		scales[i] = 1
+	}
+
+	return &FontSet{
+		faces:  append([]*Face(nil), faces...),
+		scales: scales,
+	}, nil
+}
+
+// Len reports the number of faces in the set.
+func (s *FontSet) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.faces)
+}
+
+// Face returns the face at index i, or nil if i is out of range.
+func (s *FontSet) Face(i int) *Face {
+	if s == nil || i < 0 || i >= len(s.faces) {
+		return nil
+	}
+	return s.faces[i]
+}
+
+// Resolve returns the first face that has a glyph for r, and the index of
+// that glyph.
+//
+// If no face has a glyph for r it returns the primary face and MissingGlyph,
+// so that the missing glyph is rendered consistently.
+func (s *FontSet) Resolve(r rune) (*Face, GlyphIndex) {
+	if s == nil || len(s.faces) == 0 {
+		return nil, MissingGlyph
+	}
+
+	for _, f := range s.faces {
+		if idx := f.CharIndex(r); idx != MissingGlyph {
+			return f, idx
+		}
+	}
+	return s.faces[0], MissingGlyph
+}
+
+// ResolveVariant returns the first face that has a glyph for r followed by the
+// variation selector variantSelector, and the index of that glyph.
+//
+// Variation selectors are only a hint, if no face has a glyph for the pair it
+// falls back to Resolve(r).
+//
+// See Face.CharVariantIndex.
+func (s *FontSet) ResolveVariant(r, variantSelector rune) (*Face, GlyphIndex) {
+	if s == nil || len(s.faces) == 0 {
+		return nil, MissingGlyph
+	}
+
+	for _, f := range s.faces {
+		if idx := f.CharVariantIndex(r, variantSelector); idx != MissingGlyph {
+			return f, idx
+		}
+	}
+	return s.Resolve(r)
+}
+
+// FontRun is a span of text rendered with a single face of a FontSet.
+//
+// Start and End are byte offsets into the text, End being exclusive.
+type FontRun struct {
+	Face  *Face
+	Start int
+	End   int
+}
+
+// Runs splits text into runs of consecutive runes resolving to the same face.
+//
+// Variation selectors are resolved along with the rune they follow, using
+// ResolveVariant, and always belong to its run. Combining marks and zero width
+// joiners stay in the run of the preceding rune, so that clusters are not split
+// across faces, unless only another face has a glyph for them.
+func (s *FontSet) Runs(text string) []FontRun {
+	if s == nil || len(s.faces) == 0 {
+		return nil
+	}
+
+	var ret []FontRun
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		end := i + size
+
+		var face *Face
+		var idx GlyphIndex
+		if vs, size := utf8.DecodeRuneInString(text[end:]); isVariationSelector(vs) {
+			face, idx = s.ResolveVariant(r, vs)
+			end += size
+		} else {
+			face, idx = s.Resolve(r)
+		}
+
+		if n := len(ret); n > 0 {
+			prev := &ret[n-1]
+			extends := extendsCluster(r) && (idx == MissingGlyph || prev.Face.CharIndex(r) != MissingGlyph)
+			if prev.Face == face || isVariationSelector(r) || extends {
+				prev.End = end
+				i = end
+				continue
+			}
+		}
+
+		ret = append(ret, FontRun{Face: face, Start: i, End: end})
+		i = end
+	}
+	return ret
+}
+
+// isVariationSelector reports whether r is one of the variation selectors,
+// VS1 to VS256.
+func isVariationSelector(r rune) bool {
+	return r >= 0xfe00 && r <= 0xfe0f || r >= 0xe0100 && r <= 0xe01ef
+}
+
+// extendsCluster reports whether r is a combining mark or a zero width joiner.
+func extendsCluster(r rune) bool {
+	return r == 0x200d || unicode.Is(unicode.M, r)
+}
+
+// Scale returns the factor to apply to the glyphs of f so that they line up
+// with the primary face, or 1 if f is not in the set.
+//
+// Scalable faces are sized to line up by SetPixelSizes and SetCharSize, their
+// scale is always 1. Faces with bitmap strikes only cannot be resized, their
+// bitmaps and metrics have to be scaled when rendered instead.
+func (s *FontSet) Scale(f *Face) float64 {
+	if s == nil {
+		return 1
+	}
+
+	for i, face := range s.faces {
+		if face == f {
+			return s.scales[i]
+		}
+	}
+	return 1
+}
+
+// SetPixelSizes sets the pixel size of the primary face, as Face.SetPixelSizes
+// does, and sizes the other faces so that the distance between their ascender
+// and descender matches the one of the primary face.
+//
+// It returns ErrInvalidArgument if the set is nil.
+func (s *FontSet) SetPixelSizes(width, height uint) error {
+	if s == nil || len(s.faces) == 0 {
+		return ErrInvalidArgument
+	}
+
+	if err := s.faces[0].SetPixelSizes(width, height); err != nil {
+		return err
+	}
+	return s.normalize()
+}
+
+// SetCharSize sets the character size of the primary face, as
+// Face.SetCharSize does, and sizes the other faces so that the distance
+// between their ascender and descender matches the one of the primary face.
+//
+// It returns ErrInvalidArgument if the set is nil.
+func (s *FontSet) SetCharSize(nominalWidth, nominalHeight fixed.Int26_6, horzDPI, vertDPI uint) error {
+	if s == nil || len(s.faces) == 0 {
+		return ErrInvalidArgument
+	}
+
+	if err := s.faces[0].SetCharSize(nominalWidth, nominalHeight, horzDPI, vertDPI); err != nil {
+		return err
+	}
+	return s.normalize()
+}
+
+// normalize sizes the fallback faces after the primary one.
+func (s *FontSet) normalize() error {
+	width, height := extent(s.faces[0])
+
+	for i, f := range s.faces[1:] {
+		i++
+		s.scales[i] = 1
+
+		if f.HasFlag(FaceFlagScalable) {
+			err := f.RequestSize(SizeRequest{
+				Type:   SizeRequestTypeRealDim,
+				Width:  width,
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := f.SelectSize(closestStrike(f.AvailableSizes(), height)); err != nil {
+			return err
+		}
+		if _, h := extent(f); h > 0 {
+			s.scales[i] = float64(height) / float64(h)
+		}
+	}
+	return nil
+}
+
+// extent returns the horizontal and vertical distance between the ascender and
+// the descender of f at its current size, in 26.6 pixels.
+//
+// The metrics of scalable faces are scaled from font units, to avoid the
+// rounding of their size metrics.
+func extent(f *Face) (width, height fixed.Int26_6) {
+	m := f.SizeMetrics()
+	if !f.HasFlag(FaceFlagScalable) {
+		return m.Ascender - m.Descender, m.Ascender - m.Descender
+	}
+
+	units := int64(f.Ascender() - f.Descender())
+	return mulFix(units, m.XScale), mulFix(units, m.YScale)
+}
+
+// mulFix scales a value in font units by a 16.16 scale, rounding like
+// FT_MulFix.
+func mulFix(units int64, scale fixed.Int16_16) fixed.Int26_6 {
+	v := units * int64(scale)
+	if v < 0 {
+		return -fixed.Int26_6((-v + 0x8000) >> 16)
+	}
+	return fixed.Int26_6((v + 0x8000) >> 16)
+}
+
+// closestStrike returns the index of the smallest strike at least as tall as
+// height, or of the tallest one if there is none, since scaling bitmaps down
+// looks better than scaling them up.
+func closestStrike(sizes []BitmapSize, height fixed.Int26_6) int {
+	best := 0
+	for i := 1; i < len(sizes); i++ {
+		h, bh := fixed.Int26_6(sizes[i].Height)<<6, fixed.Int26_6(sizes[best].Height)<<6
+		if bh < height && h > bh || bh >= height && h >= height && h < bh {
+			best = i
+		}
+	}
+	return best
+}
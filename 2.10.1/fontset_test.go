@@ -0,0 +1,287 @@
+package freetype2
+
+import (
+	"testing"
+
+	"github.com/flga/freetype2/fixed"
+)
+
+// openFontSet opens the given faces and builds a FontSet out of them, in
+// order. The returned func frees the faces.
+func openFontSet(t *testing.T, faces ...func() (testface, error)) (*FontSet, []testface, func()) {
+	t.Helper()
+
+	var opened []testface
+	free := func() {
+		for _, f := range opened {
+			f.Free()
+		}
+	}
+
+	var ptrs []*Face
+	for _, open := range faces {
+		face, err := open()
+		if err != nil {
+			free()
+			t.Fatalf("unable to open face: %v", err)
+		}
+		opened = append(opened, face)
+		ptrs = append(ptrs, face.Face)
+	}
+
+	set, err := NewFontSet(ptrs...)
+	if err != nil {
+		free()
+		t.Fatalf("NewFontSet() error = %v", err)
+	}
+	return set, opened, free
+}
+
+func TestNewFontSet(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+
+	tests := []struct {
+		name    string
+		faces   []*Face
+		wantErr error
+	}{
+		{name: "no faces", faces: nil, wantErr: ErrInvalidArgument},
+		{name: "nil face", faces: []*Face{face.Face, nil}, wantErr: ErrInvalidFaceHandle},
+		{name: "freed face", faces: []*Face{{}}, wantErr: ErrInvalidFaceHandle},
+		{name: "ok", faces: []*Face{face.Face, face.Face}, wantErr: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFontSet(tt.faces...)
+			if err != tt.wantErr {
+				t.Fatalf("NewFontSet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if got != nil {
+					t.Errorf("NewFontSet() = %v, want nil", got)
+				}
+				return
+			}
+			if got.Len() != len(tt.faces) {
+				t.Errorf("FontSet.Len() = %v, want %v", got.Len(), len(tt.faces))
+			}
+			for i, want := range tt.faces {
+				if got.Face(i) != want {
+					t.Errorf("FontSet.Face(%d) = %p, want %p", i, got.Face(i), want)
+				}
+			}
+			if got.Face(-1) != nil || got.Face(len(tt.faces)) != nil {
+				t.Errorf("FontSet.Face() out of range should be nil")
+			}
+		})
+	}
+}
+
+func TestFontSet_nil(t *testing.T) {
+	var s *FontSet
+	if got := s.Len(); got != 0 {
+		t.Errorf("FontSet.Len() = %v, want 0", got)
+	}
+	if got := s.Face(0); got != nil {
+		t.Errorf("FontSet.Face() = %v, want nil", got)
+	}
+	if f, idx := s.Resolve('a'); f != nil || idx != MissingGlyph {
+		t.Errorf("FontSet.Resolve() = %v, %v, want nil, %v", f, idx, MissingGlyph)
+	}
+	if f, idx := s.ResolveVariant('a', 0xfe00); f != nil || idx != MissingGlyph {
+		t.Errorf("FontSet.ResolveVariant() = %v, %v, want nil, %v", f, idx, MissingGlyph)
+	}
+	if got := s.Runs("a"); got != nil {
+		t.Errorf("FontSet.Runs() = %v, want nil", got)
+	}
+	if got := s.Scale(nil); got != 1 {
+		t.Errorf("FontSet.Scale() = %v, want 1", got)
+	}
+	if err := s.SetPixelSizes(0, 16); err != ErrInvalidArgument {
+		t.Errorf("FontSet.SetPixelSizes() error = %v, want %v", err, ErrInvalidArgument)
+	}
+	if err := s.SetCharSize(12*64, 12*64, 72, 72); err != ErrInvalidArgument {
+		t.Errorf("FontSet.SetCharSize() error = %v, want %v", err, ErrInvalidArgument)
+	}
+}
+
+func TestFontSet_Resolve(t *testing.T) {
+	set, faces, free := openFontSet(t, goRegular, notoSansJpThin, twemojiMozilla)
+	defer free()
+
+	tests := []struct {
+		name      string
+		r         rune
+		wantFace  int
+		wantIndex GlyphIndex
+	}{
+		{name: "latin", r: 'a', wantFace: 0, wantIndex: 68},
+		{name: "space", r: ' ', wantFace: 0, wantIndex: 3},
+		{name: "ideograph", r: '日', wantFace: 1, wantIndex: 6309},
+		{name: "symbol", r: '☃', wantFace: 1, wantIndex: 1016},
+		{name: "emoji", r: 0x1f600, wantFace: 2, wantIndex: 883},
+		{name: "missing", r: 0x10ffff, wantFace: 0, wantIndex: MissingGlyph},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, idx := set.Resolve(tt.r)
+			if face != faces[tt.wantFace].Face {
+				t.Errorf("FontSet.Resolve() face = %s, want %s", face.FamilyName(), faces[tt.wantFace].FamilyName())
+			}
+			if idx != tt.wantIndex {
+				t.Errorf("FontSet.Resolve() index = %v, want %v", idx, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestFontSet_ResolveVariant(t *testing.T) {
+	set, faces, free := openFontSet(t, goRegular, notoSansJpThin)
+	defer free()
+
+	tests := []struct {
+		name      string
+		r, vs     rune
+		wantFace  int
+		wantIndex GlyphIndex
+	}{
+		{name: "variant", r: 0x845b, vs: 0xe0100, wantFace: 1, wantIndex: 16220},
+		{name: "unknown variant", r: 0x845b, vs: 0xfe00, wantFace: 1, wantIndex: faces[1].CharIndex(0x845b)},
+		{name: "no variants", r: 'a', vs: 0xfe00, wantFace: 0, wantIndex: 68},
+		{name: "missing", r: 0x10ffff, vs: 0xfe00, wantFace: 0, wantIndex: MissingGlyph},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, idx := set.ResolveVariant(tt.r, tt.vs)
+			if face != faces[tt.wantFace].Face {
+				t.Errorf("FontSet.ResolveVariant() face = %s, want %s", face.FamilyName(), faces[tt.wantFace].FamilyName())
+			}
+			if idx != tt.wantIndex {
+				t.Errorf("FontSet.ResolveVariant() index = %v, want %v", idx, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestFontSet_Runs(t *testing.T) {
+	set, faces, free := openFontSet(t, goRegular, notoSansJpThin, twemojiMozilla)
+	defer free()
+
+	type run struct {
+		face       int
+		start, end int
+	}
+	tests := []struct {
+		name string
+		text string
+		want []run
+	}{
+		{name: "empty", text: "", want: nil},
+		{name: "single face", text: "Hello", want: []run{{0, 0, 5}}},
+		{
+			name: "mixed",
+			text: "Hi 日本 😀!",
+			want: []run{{0, 0, 3}, {1, 3, 9}, {0, 9, 10}, {2, 10, 14}, {0, 14, 15}},
+		},
+		{name: "variation selector", text: "a葛\U000E0100", want: []run{{0, 0, 1}, {1, 1, 8}}},
+		{name: "emoji presentation", text: "a\u2764\ufe0f", want: []run{{0, 0, 1}, {2, 1, 7}}},
+		{name: "leading variation selector", text: "\ufe0fa", want: []run{{2, 0, 3}, {0, 3, 4}}},
+		{name: "zwj sequence", text: "a\U0001F469\u200d\U0001F4BB", want: []run{{0, 0, 1}, {2, 1, 12}}},
+		{name: "combining mark", text: "日\u0301", want: []run{{1, 0, 5}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []run
+			for _, r := range set.Runs(tt.text) {
+				face := -1
+				for i, f := range faces {
+					if f.Face == r.Face {
+						face = i
+					}
+				}
+				got = append(got, run{face, r.Start, r.End})
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestFontSet_SetPixelSizes(t *testing.T) {
+	set, faces, free := openFontSet(t, goRegular, notoSansJpThin, twemojiMozilla, gohuBdf)
+	defer free()
+
+	if err := set.SetPixelSizes(0, 16); err != nil {
+		t.Fatalf("FontSet.SetPixelSizes() error = %v", err)
+	}
+
+	if got := faces[0].SizeMetrics().YPpem; got != 16 {
+		t.Errorf("primary face ppem = %v, want 16", got)
+	}
+
+	_, want := extent(faces[0].Face)
+	for _, f := range faces[1:3] {
+		if _, got := extent(f.Face); got < want-64 || got > want+64 {
+			t.Errorf("%s extent = %v, want %v", f.FamilyName(), got, want)
+		}
+		if got := set.Scale(f.Face); got != 1 {
+			t.Errorf("FontSet.Scale(%s) = %v, want 1", f.FamilyName(), got)
+		}
+	}
+
+	// bitmap only faces keep their strike, and report the scale to apply.
+	_, h := extent(faces[3].Face)
+	if got, want := set.Scale(faces[3].Face), float64(want)/float64(h); got != want {
+		t.Errorf("FontSet.Scale(%s) = %v, want %v", faces[3].FamilyName(), got, want)
+	}
+	if got := set.Scale(nil); got != 1 {
+		t.Errorf("FontSet.Scale(nil) = %v, want 1", got)
+	}
+}
+
+func TestFontSet_SetCharSize(t *testing.T) {
+	set, faces, free := openFontSet(t, goRegular, notoSansJpThin)
+	defer free()
+
+	if err := set.SetCharSize(12*64, 0, 96, 96); err != nil {
+		t.Fatalf("FontSet.SetCharSize() error = %v", err)
+	}
+	if got := faces[0].SizeMetrics().YPpem; got != 16 {
+		t.Errorf("primary face ppem = %v, want 16", got)
+	}
+
+	_, want := extent(faces[0].Face)
+	if _, got := extent(faces[1].Face); got < want-64 || got > want+64 {
+		t.Errorf("%s extent = %v, want %v", faces[1].FamilyName(), got, want)
+	}
+	// the ideographs are smaller than the nominal size, as they are taller.
+	if got := faces[1].SizeMetrics().YPpem; got >= 16 {
+		t.Errorf("fallback face ppem = %v, want less than 16", got)
+	}
+}
+
+func Test_closestStrike(t *testing.T) {
+	sizes := []BitmapSize{{Height: 12}, {Height: 24}, {Height: 18}}
+	tests := []struct {
+		name   string
+		height fixed.Int26_6
+		want   int
+	}{
+		{name: "exact", height: 18 * 64, want: 2},
+		{name: "between", height: 13 * 64, want: 2},
+		{name: "smaller than all", height: 8 * 64, want: 0},
+		{name: "larger than all", height: 30 * 64, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := closestStrike(sizes, tt.height); got != tt.want {
+				t.Errorf("closestStrike() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -113,6 +113,25 @@ type Glyph interface {
 	//
 	// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_management.html#ft_glyph_to_bitmap
 	ToBitmap(mode RenderMode, origin Vector26_6, destroy bool) (*BitmapGlyph, error)
+	// Stroke strokes a given outline glyph object with a given stroker.
+	//
+	// The destroy argument indicates wether the original glyph image should be
+	// destroyed by this function. It is never destroyed in case of error.
+	//
+	// If the glyph is not an outline it returns ErrInvalidArgument.
+	//
+	// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_glyph_stroke
+	Stroke(stroker *Stroker, destroy bool) (*OutlineGlyph, error)
+	// StrokeBorder strokes a given outline glyph object with a given stroker,
+	// but only return either its inside or outside border.
+	//
+	// The destroy argument indicates wether the original glyph image should be
+	// destroyed by this function. It is never destroyed in case of error.
+	//
+	// If the glyph is not an outline it returns ErrInvalidArgument.
+	//
+	// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_glyph_strokeborder
+	StrokeBorder(stroker *Stroker, inside, destroy bool) (*OutlineGlyph, error)
 }
 
 func newGlyph(g C.FT_Glyph) (Glyph, error) {
@@ -412,6 +431,31 @@ func (g *BitmapGlyph) ToBitmap(mode RenderMode, origin Vector26_6, destroy bool)
 	return glyphToBitmap(g, mode, origin, destroy)
 }
 
+// Stroke strokes a given outline glyph object with a given stroker.
+//
+// The destroy argument indicates wether the original glyph image should be
+// destroyed by this function. It is never destroyed in case of error.
+//
+// If the glyph is not an outline it returns ErrInvalidArgument.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_glyph_stroke
+func (g *BitmapGlyph) Stroke(stroker *Stroker, destroy bool) (*OutlineGlyph, error) {
+	return glyphStroke(g, stroker, destroy)
+}
+
+// StrokeBorder strokes a given outline glyph object with a given stroker, but
+// only return either its inside or outside border.
+//
+// The destroy argument indicates wether the original glyph image should be
+// destroyed by this function. It is never destroyed in case of error.
+//
+// If the glyph is not an outline it returns ErrInvalidArgument.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_glyph_strokeborder
+func (g *BitmapGlyph) StrokeBorder(stroker *Stroker, inside, destroy bool) (*OutlineGlyph, error) {
+	return glyphStrokeBorder(g, stroker, inside, destroy)
+}
+
 var _ Glyph = &OutlineGlyph{}
 
 // OutlineGlyph models an outline (vectorial) glyph image.
@@ -583,6 +627,31 @@ func (g *OutlineGlyph) ToBitmap(mode RenderMode, origin Vector26_6, destroy bool
 	return glyphToBitmap(g, mode, origin, destroy)
 }
 
+// Stroke strokes a given outline glyph object with a given stroker.
+//
+// The destroy argument indicates wether the original glyph image should be
+// destroyed by this function. It is never destroyed in case of error.
+//
+// If the glyph is not an outline it returns ErrInvalidArgument.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_glyph_stroke
+func (g *OutlineGlyph) Stroke(stroker *Stroker, destroy bool) (*OutlineGlyph, error) {
+	return glyphStroke(g, stroker, destroy)
+}
+
+// StrokeBorder strokes a given outline glyph object with a given stroker, but
+// only return either its inside or outside border.
+//
+// The destroy argument indicates wether the original glyph image should be
+// destroyed by this function. It is never destroyed in case of error.
+//
+// If the glyph is not an outline it returns ErrInvalidArgument.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_glyph_strokeborder
+func (g *OutlineGlyph) StrokeBorder(stroker *Stroker, inside, destroy bool) (*OutlineGlyph, error) {
+	return glyphStrokeBorder(g, stroker, inside, destroy)
+}
+
 // NewGlyph creates a new empty glyph image. Note that the created Glyph must be
 // released with Free.
 //
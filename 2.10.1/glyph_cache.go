@@ -0,0 +1,306 @@
+package freetype2
+
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include FT_MULTIPLE_MASTERS_H
+import "C"
+
+import (
+	"container/list"
+	"image"
+	"image/color"
+	"sync"
+	"unsafe"
+)
+
+// glyphCacheOverhead is the approximate size of an entry, excluding its bitmap
+// buffer, counted against the cache budget.
+const glyphCacheOverhead = int(unsafe.Sizeof(CachedGlyph{}) + unsafe.Sizeof(glyphCacheKey{}) + 64)
+
+// CachedGlyph is a rendered glyph held by a GlyphCache.
+//
+// Its bitmap is shared by every user of the cache, so it can only be read,
+// through the image.Image interface, or copied with Bitmap.
+type CachedGlyph struct {
+	bitmap Bitmap
+
+	// The glyph index in the face.
+	GlyphIndex GlyphIndex
+	// The metrics of the glyph, see GlyphSlot.Metrics.
+	Metrics GlyphMetrics
+	// The transformed advance, see GlyphSlot.Advance.
+	Advance Vector26_6
+	// The bitmap's left bearing expressed in integer pixels.
+	BitmapLeft int
+	// The bitmap's top bearing expressed in integer pixels. This is the
+	// distance from the baseline to the top-most glyph scanline, upwards y
+	// coordinates being positive.
+	BitmapTop int
+	// The difference between hinted and unhinted left side bearing while
+	// auto-hinting is active. Zero otherwise.
+	LsbDelta Pos
+	// The difference between hinted and unhinted right side bearing while
+	// auto-hinting is active. Zero otherwise.
+	RsbDelta Pos
+}
+
+// Bitmap returns a copy of the glyph's bitmap, which is not backed by
+// FreeType, see Library.NewBitmapFromImage to get one.
+func (g CachedGlyph) Bitmap() Bitmap {
+	ret := g.bitmap
+	ret.Buffer = append([]byte(nil), g.bitmap.Buffer...)
+	return ret
+}
+
+// ColorModel implements the image.Image interface, see Bitmap.ColorModel.
+func (g CachedGlyph) ColorModel() color.Model { return g.bitmap.ColorModel() }
+
+// Bounds implements the image.Image interface, see Bitmap.Bounds.
+func (g CachedGlyph) Bounds() image.Rectangle { return g.bitmap.Bounds() }
+
+// At implements the image.Image interface, see Bitmap.At.
+func (g CachedGlyph) At(x, y int) color.Color { return g.bitmap.At(x, y) }
+
+type glyphCacheKey struct {
+	face      *Face
+	size      C.FT_Size_Metrics
+	named     int
+	coords    string
+	synthetic SyntheticStyle
+	index     GlyphIndex
+	flags     LoadFlag
+	mode      RenderMode
+	subpixel  Pos
+}
+
+type glyphCacheEntry struct {
+	key   glyphCacheKey
+	glyph CachedGlyph
+	size  int
+}
+
+// GlyphCache caches rendered glyphs, to avoid loading and rendering them again
+// when drawing the same text repeatedly.
+//
+// Glyphs are identified by their face, the face's active size, named instance,
+// variation coordinates and synthetic style, and the arguments of Glyph. The
+// face's transform, set by Face.SetTransform, is not taken into account, so
+// faces with a transform should not be shared with a cache.
+//
+// The cache holds at most budget bytes of glyphs, evicting the least recently
+// used ones to make room for new glyphs. It is safe for concurrent use, as long
+// as the faces are not used concurrently elsewhere.
+type GlyphCache struct {
+	mu      sync.Mutex
+	budget  int
+	used    int
+	lru     *list.List
+	entries map[glyphCacheKey]*list.Element
+	faces   map[*Face]int // the number of variation axes of each face
+
+	// the faces whose dealloc purges the cache, they are kept across calls to
+	// PurgeFace so that they are registered only once.
+	registered map[*Face]bool
+}
+
+// NewGlyphCache creates a cache holding at most budget bytes of glyphs.
+func NewGlyphCache(budget int) *GlyphCache {
+	return &GlyphCache{
+		budget:  budget,
+		lru:     list.New(),
+		entries: make(map[glyphCacheKey]*list.Element),
+		faces:   make(map[*Face]int),
+
+		registered: make(map[*Face]bool),
+	}
+}
+
+// Glyph returns the glyph idx of the face, loaded with flags and rendered with
+// mode, loading and rendering it if it's not in the cache already.
+//
+// The outline is moved right by subpixel before rendering, which is reduced to
+// the fractional part of a pixel, so that glyphs can be positioned with
+// subpixel accuracy. It has no effect on bitmap glyphs.
+//
+// Note that the face's glyph slot is modified when the glyph is not cached.
+// The face's glyphs are removed from the cache when it is freed.
+func (c *GlyphCache) Glyph(f *Face, idx GlyphIndex, flags LoadFlag, mode RenderMode, subpixel Pos) (CachedGlyph, error) {
+	if c == nil {
+		return CachedGlyph{}, ErrInvalidArgument
+	}
+
+	if f == nil || f.ptr == nil {
+		return CachedGlyph{}, ErrInvalidFaceHandle
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := c.key(f, idx, flags&^LoadRender, mode, subpixel&63)
+	if e, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(e)
+		return e.Value.(*glyphCacheEntry).glyph, nil
+	}
+
+	glyph, err := renderCachedGlyph(f, key)
+	if err != nil {
+		return CachedGlyph{}, err
+	}
+
+	size := len(glyph.bitmap.Buffer) + glyphCacheOverhead
+	if size > c.budget {
+		return glyph, nil
+	}
+
+	for c.used+size > c.budget {
+		c.remove(c.lru.Back())
+	}
+	c.entries[key] = c.lru.PushFront(&glyphCacheEntry{key: key, glyph: glyph, size: size})
+	c.used += size
+
+	return glyph, nil
+}
+
+// Len returns the number of glyphs in the cache.
+func (c *GlyphCache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Size returns the number of bytes used by the glyphs in the cache.
+func (c *GlyphCache) Size() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.used
+}
+
+// Purge removes every glyph from the cache.
+func (c *GlyphCache) Purge() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+	}
+}
+
+// PurgeFace removes the glyphs of f from the cache.
+func (c *GlyphCache) PurgeFace(f *Face) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for e := c.lru.Front(); e != nil; {
+		next := e.Next()
+		if e.Value.(*glyphCacheEntry).key.face == f {
+			c.remove(e)
+		}
+		e = next
+	}
+	delete(c.faces, f)
+}
+
+// release removes f from the cache when it is freed.
+func (c *GlyphCache) release(f *Face) {
+	c.PurgeFace(f)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.registered, f)
+}
+
+func (c *GlyphCache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*glyphCacheEntry)
+	delete(c.entries, entry.key)
+	c.used -= entry.size
+}
+
+func (c *GlyphCache) key(f *Face, idx GlyphIndex, flags LoadFlag, mode RenderMode, subpixel Pos) glyphCacheKey {
+	axes, ok := c.faces[f]
+	if !ok {
+		if f.HasFlag(FaceFlagMultipleMasters) {
+			if mm, err := f.MMVar(); err == nil {
+				axes = int(mm.NumAxis)
+			}
+		}
+		c.faces[f] = axes
+	}
+	if !c.registered[f] {
+		c.registered[f] = true
+		f.dealloc = append(f.dealloc, func() { c.release(f) })
+	}
+
+	key := glyphCacheKey{
+		face:      f,
+		named:     f.NamedIndex(),
+		synthetic: f.SyntheticStyle(),
+		index:     idx,
+		flags:     flags,
+		mode:      mode,
+		subpixel:  subpixel,
+	}
+	if f.ptr.size != nil {
+		key.size = f.ptr.size.metrics
+	}
+	if axes > 0 {
+		coords := make([]C.FT_Fixed, axes)
+		if C.FT_Get_Var_Blend_Coordinates(f.ptr, C.uint(axes), &coords[0]) == 0 {
+			key.coords = string(C.GoBytes(unsafe.Pointer(&coords[0]), C.int(axes*C.sizeof_FT_Fixed)))
+		}
+	}
+
+	return key
+}
+
+func renderCachedGlyph(f *Face, key glyphCacheKey) (CachedGlyph, error) {
+	if err := f.LoadGlyph(key.index, key.flags); err != nil {
+		return CachedGlyph{}, err
+	}
+
+	slot := f.GlyphSlot()
+	if slot.Format == GlyphFormatOutline {
+		if key.subpixel != 0 {
+			slot.Outline.Translate(key.subpixel, 0)
+		}
+		if err := slot.RenderGlyph(key.mode); err != nil {
+			return CachedGlyph{}, err
+		}
+	}
+
+	ret := CachedGlyph{
+		GlyphIndex: key.index,
+		Metrics:    slot.Metrics,
+		Advance:    slot.Advance,
+		BitmapLeft: slot.BitmapLeft,
+		BitmapTop:  slot.BitmapTop,
+		LsbDelta:   slot.LsbDelta,
+		RsbDelta:   slot.RsbDelta,
+	}
+	if b := slot.Bitmap; b != nil {
+		// reload allocates a new buffer every time, so it can be kept as is.
+		ret.bitmap = Bitmap{
+			Rows:      b.Rows,
+			Width:     b.Width,
+			Pitch:     b.Pitch,
+			Buffer:    b.Buffer,
+			NumGrays:  b.NumGrays,
+			PixelMode: b.PixelMode,
+		}
+	}
+
+	return ret, nil
+}
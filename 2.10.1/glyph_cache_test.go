@@ -0,0 +1,320 @@
+package freetype2
+
+import (
+	"testing"
+
+	"github.com/flga/freetype2/fixed"
+)
+
+func TestGlyphCache_Glyph(t *testing.T) {
+	var nilCache *GlyphCache
+	if _, err := nilCache.Glyph(nil, 0, LoadDefault, RenderModeNormal, 0); err != ErrInvalidArgument {
+		t.Errorf("GlyphCache.Glyph() error = %v, want %v", err, ErrInvalidArgument)
+	}
+
+	c := NewGlyphCache(1 << 20)
+	if _, err := c.Glyph(nil, 0, LoadDefault, RenderModeNormal, 0); err != ErrInvalidFaceHandle {
+		t.Errorf("GlyphCache.Glyph() error = %v, want %v", err, ErrInvalidFaceHandle)
+	}
+
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set size: %v", err)
+	}
+
+	idx := face.CharIndex('A')
+	got, err := c.Glyph(face.Face, idx, LoadDefault, RenderModeNormal, 0)
+	if err != nil {
+		t.Fatalf("GlyphCache.Glyph() error = %v", err)
+	}
+
+	// the cached glyph must match what FreeType renders.
+	if err := face.LoadGlyph(idx, LoadRender); err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+	slot := face.GlyphSlot()
+	want := CachedGlyph{
+		bitmap: Bitmap{
+			Rows:      slot.Bitmap.Rows,
+			Width:     slot.Bitmap.Width,
+			Pitch:     slot.Bitmap.Pitch,
+			Buffer:    slot.Bitmap.Buffer,
+			NumGrays:  slot.Bitmap.NumGrays,
+			PixelMode: slot.Bitmap.PixelMode,
+		},
+		GlyphIndex: idx,
+		Metrics:    slot.Metrics,
+		Advance:    slot.Advance,
+		BitmapLeft: slot.BitmapLeft,
+		BitmapTop:  slot.BitmapTop,
+	}
+	if diff := diff(got, want); diff != nil {
+		t.Errorf("GlyphCache.Glyph() = %v", diff)
+	}
+
+	if got, err := c.Glyph(face.Face, idx, LoadDefault|LoadRender, RenderModeNormal, 64); err != nil || c.Len() != 1 {
+		t.Errorf("GlyphCache.Glyph() error = %v, Len() = %v, want a cache hit", err, c.Len())
+	} else if diff := diff(got, want); diff != nil {
+		t.Errorf("GlyphCache.Glyph() = %v", diff)
+	}
+
+	// modifying a copy must not affect the cache.
+	b := got.Bitmap()
+	b.Buffer[0] = 0x42
+	if diff := diff(got.Bitmap(), want.Bitmap()); diff != nil {
+		t.Errorf("CachedGlyph.Bitmap() = %v", diff)
+	}
+	if got.ColorModel() != want.bitmap.ColorModel() || got.Bounds() != want.bitmap.Bounds() || got.At(4, 4) != want.bitmap.At(4, 4) {
+		t.Errorf("CachedGlyph does not match its bitmap")
+	}
+}
+
+func TestGlyphCache_key(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set size: %v", err)
+	}
+
+	c := NewGlyphCache(1 << 20)
+	idx := face.CharIndex('A')
+	base, err := c.Glyph(face.Face, idx, LoadDefault, RenderModeNormal, 0)
+	if err != nil {
+		t.Fatalf("GlyphCache.Glyph() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T)
+		idx     GlyphIndex
+		flags   LoadFlag
+		mode    RenderMode
+		offset  Pos
+		wantLen int
+		// whether the glyph is the first one.
+		same bool
+	}{
+		{name: "hit", idx: idx, wantLen: 1, same: true},
+		{name: "glyph", idx: face.CharIndex('B'), wantLen: 2},
+		{name: "flags", idx: idx, flags: LoadNoHinting, wantLen: 3},
+		{name: "mode", idx: idx, mode: RenderModeMono, wantLen: 4},
+		{name: "subpixel", idx: idx, offset: 32, wantLen: 5},
+		{name: "subpixel hit", idx: idx, offset: 64 + 32, wantLen: 5},
+		{
+			name: "size",
+			setup: func(t *testing.T) {
+				if err := face.SetCharSize(20<<6, 20<<6, 72, 72); err != nil {
+					t.Fatalf("unable to set size: %v", err)
+				}
+			},
+			idx:     idx,
+			wantLen: 6,
+		},
+		{
+			name: "synthetic",
+			setup: func(t *testing.T) {
+				face.SetSyntheticStyle(SyntheticBold)
+			},
+			idx:     idx,
+			wantLen: 7,
+		},
+		{
+			name: "back to the start",
+			setup: func(t *testing.T) {
+				face.SetSyntheticStyle(0)
+				if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+					t.Fatalf("unable to set size: %v", err)
+				}
+			},
+			idx:     idx,
+			wantLen: 7,
+			same:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(t)
+			}
+
+			got, err := c.Glyph(face.Face, tt.idx, tt.flags, tt.mode, tt.offset)
+			if err != nil {
+				t.Fatalf("GlyphCache.Glyph() error = %v", err)
+			}
+			if c.Len() != tt.wantLen {
+				t.Errorf("GlyphCache.Len() = %v, want %v", c.Len(), tt.wantLen)
+			}
+
+			if same := diff(got, base) == nil; same != tt.same {
+				t.Errorf("GlyphCache.Glyph() same as the first glyph = %v, want %v", same, tt.same)
+			}
+		})
+	}
+}
+
+func TestGlyphCache_variations(t *testing.T) {
+	face, err := faceFromPath("variable/FiraCode/FiraCode-VF.ttf")()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set size: %v", err)
+	}
+
+	c := NewGlyphCache(1 << 20)
+	idx := face.CharIndex('A')
+	light, err := c.Glyph(face.Face, idx, LoadDefault, RenderModeNormal, 0)
+	if err != nil {
+		t.Fatalf("GlyphCache.Glyph() error = %v", err)
+	}
+
+	if err := face.SetVarDesignCoords([]fixed.Int16_16{700 << 16}); err != nil {
+		t.Fatalf("unable to set coords: %v", err)
+	}
+	bold, err := c.Glyph(face.Face, idx, LoadDefault, RenderModeNormal, 0)
+	if err != nil {
+		t.Fatalf("GlyphCache.Glyph() error = %v", err)
+	}
+
+	if c.Len() != 2 {
+		t.Errorf("GlyphCache.Len() = %v, want 2", c.Len())
+	}
+	if diff(light, bold) == nil {
+		t.Errorf("GlyphCache.Glyph() returned the same glyph for different coordinates")
+	}
+}
+
+func TestGlyphCache_eviction(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetPixelSizes(0, 16); err != nil {
+		t.Fatalf("unable to set size: %v", err)
+	}
+
+	// every glyph is cached once to measure it.
+	sizes := map[rune]int{}
+	for _, r := range "ABCD" {
+		g, err := NewGlyphCache(1<<20).Glyph(face.Face, face.CharIndex(r), LoadDefault, RenderModeNormal, 0)
+		if err != nil {
+			t.Fatalf("GlyphCache.Glyph() error = %v", err)
+		}
+		sizes[r] = len(g.bitmap.Buffer) + glyphCacheOverhead
+	}
+
+	c := NewGlyphCache(sizes['A'] + sizes['B'] + sizes['C'])
+	get := func(r rune) {
+		if _, err := c.Glyph(face.Face, face.CharIndex(r), LoadDefault, RenderModeNormal, 0); err != nil {
+			t.Fatalf("GlyphCache.Glyph() error = %v", err)
+		}
+	}
+	cached := func(r rune) bool {
+		key := c.key(face.Face, face.CharIndex(r), LoadDefault, RenderModeNormal, 0)
+		_, ok := c.entries[key]
+		return ok
+	}
+
+	get('A')
+	get('B')
+	get('C')
+	if c.Len() != 3 || c.Size() != sizes['A']+sizes['B']+sizes['C'] {
+		t.Errorf("GlyphCache.Len() = %v, Size() = %v", c.Len(), c.Size())
+	}
+
+	// A is now the most recently used, B gets evicted to make room for D.
+	get('A')
+	get('D')
+	if !cached('A') || cached('B') || !cached('D') {
+		t.Errorf("GlyphCache evicted the wrong glyphs: A %v, B %v, C %v, D %v", cached('A'), cached('B'), cached('C'), cached('D'))
+	}
+	if c.Size() > sizes['A']+sizes['B']+sizes['C'] {
+		t.Errorf("GlyphCache.Size() = %v, over budget", c.Size())
+	}
+
+	// glyphs bigger than the budget are returned but not cached.
+	small := NewGlyphCache(1)
+	if _, err := small.Glyph(face.Face, face.CharIndex('A'), LoadDefault, RenderModeNormal, 0); err != nil {
+		t.Fatalf("GlyphCache.Glyph() error = %v", err)
+	}
+	if small.Len() != 0 || small.Size() != 0 {
+		t.Errorf("GlyphCache.Len() = %v, Size() = %v, want 0", small.Len(), small.Size())
+	}
+}
+
+func TestGlyphCache_Purge(t *testing.T) {
+	regular, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer regular.Free()
+
+	bold, err := goBold()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer bold.Free()
+
+	c := NewGlyphCache(1 << 20)
+	fill := func() {
+		for _, f := range []testface{regular, bold} {
+			if err := f.SetPixelSizes(0, 16); err != nil {
+				t.Fatalf("unable to set size: %v", err)
+			}
+			for _, r := range "AB" {
+				if _, err := c.Glyph(f.Face, f.CharIndex(r), LoadDefault, RenderModeNormal, 0); err != nil {
+					t.Fatalf("GlyphCache.Glyph() error = %v", err)
+				}
+			}
+		}
+	}
+
+	fill()
+	c.PurgeFace(regular.Face)
+	if c.Len() != 2 {
+		t.Errorf("GlyphCache.PurgeFace() Len() = %v, want 2", c.Len())
+	}
+
+	c.Purge()
+	if c.Len() != 0 || c.Size() != 0 {
+		t.Errorf("GlyphCache.Purge() Len() = %v, Size() = %v, want 0", c.Len(), c.Size())
+	}
+
+	// purging must not register the faces again.
+	dealloc := len(regular.dealloc)
+	for i := 0; i < 3; i++ {
+		c.PurgeFace(regular.Face)
+		fill()
+	}
+	if got := len(regular.dealloc); got != dealloc {
+		t.Errorf("GlyphCache.PurgeFace() len(dealloc) = %v, want %v", got, dealloc)
+	}
+
+	fill()
+	if err := bold.Free(); err != nil {
+		t.Fatalf("unable to free face: %v", err)
+	}
+	if c.Len() != 2 {
+		t.Errorf("Face.Free() Len() = %v, want 2", c.Len())
+	}
+
+	var nilCache *GlyphCache
+	nilCache.Purge()
+	nilCache.PurgeFace(nil)
+	if nilCache.Len() != 0 || nilCache.Size() != 0 {
+		t.Errorf("nil GlyphCache is not empty")
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * Declarations of the parts of the HarfBuzz API used by the bindings, for the
+ * static builds. The prebuilt libraries are HarfBuzz 2.5.3 and ship without
+ * headers, builds using pkg-config include the system headers instead.
+ *
+ * See https://harfbuzz.github.io/reference-manual.html
+ */
+
+#ifndef FREETYPE2_HARFBUZZ_H
+#define FREETYPE2_HARFBUZZ_H
+
+#include <stdint.h>
+#include <ft2build.h>
+#include FT_FREETYPE_H
+
+#define HB_VERSION_MAJOR 2
+#define HB_VERSION_MINOR 5
+#define HB_VERSION_MICRO 3
+
+#define HB_VERSION_ATLEAST(major, minor, micro) \
+	((major) * 10000 + (minor) * 100 + (micro) <= \
+	 HB_VERSION_MAJOR * 10000 + HB_VERSION_MINOR * 100 + HB_VERSION_MICRO)
+
+/* hb-common.h */
+
+typedef int hb_bool_t;
+typedef uint32_t hb_codepoint_t;
+typedef int32_t hb_position_t;
+typedef uint32_t hb_mask_t;
+typedef uint32_t hb_tag_t;
+
+typedef union _hb_var_int_t {
+	uint32_t u32;
+	int32_t i32;
+	uint16_t u16[2];
+	int16_t i16[2];
+	uint8_t u8[4];
+	int8_t i8[4];
+} hb_var_int_t;
+
+typedef enum {
+	HB_DIRECTION_INVALID = 0,
+	HB_DIRECTION_LTR = 4,
+	HB_DIRECTION_RTL,
+	HB_DIRECTION_TTB,
+	HB_DIRECTION_BTT
+} hb_direction_t;
+
+/* the values of hb_script_t are tags, only the ones from hb_script_from_string
+ * are used. */
+typedef enum {
+	HB_SCRIPT_INVALID = 0,
+	_HB_SCRIPT_MAX_VALUE = INT32_MAX
+} hb_script_t;
+
+typedef const struct hb_language_impl_t *hb_language_t;
+
+typedef struct hb_feature_t {
+	hb_tag_t tag;
+	uint32_t value;
+	unsigned int start;
+	unsigned int end;
+} hb_feature_t;
+
+#define HB_FEATURE_GLOBAL_START 0
+#define HB_FEATURE_GLOBAL_END ((unsigned int) -1)
+
+typedef void (*hb_destroy_func_t)(void *user_data);
+
+hb_bool_t hb_feature_from_string(const char *str, int len, hb_feature_t *feature);
+void hb_feature_to_string(hb_feature_t *feature, char *buf, unsigned int size);
+hb_script_t hb_script_from_string(const char *str, int len);
+hb_language_t hb_language_from_string(const char *str, int len);
+
+/* hb-blob.h */
+
+typedef enum {
+	HB_MEMORY_MODE_DUPLICATE,
+	HB_MEMORY_MODE_READONLY,
+	HB_MEMORY_MODE_WRITABLE,
+	HB_MEMORY_MODE_READONLY_MAY_MAKE_WRITABLE
+} hb_memory_mode_t;
+
+typedef struct hb_blob_t hb_blob_t;
+
+hb_blob_t *hb_blob_create(const char *data, unsigned int length, hb_memory_mode_t mode, void *user_data, hb_destroy_func_t destroy);
+void hb_blob_destroy(hb_blob_t *blob);
+const char *hb_blob_get_data(hb_blob_t *blob, unsigned int *length);
+
+/* hb-set.h */
+
+typedef struct hb_set_t hb_set_t;
+
+void hb_set_clear(hb_set_t *set);
+void hb_set_add(hb_set_t *set, hb_codepoint_t codepoint);
+void hb_set_del(hb_set_t *set, hb_codepoint_t codepoint);
+
+/* hb-face.h */
+
+typedef struct hb_face_t hb_face_t;
+
+hb_face_t *hb_face_create(hb_blob_t *blob, unsigned int index);
+hb_face_t *hb_face_get_empty(void);
+void hb_face_destroy(hb_face_t *face);
+hb_blob_t *hb_face_reference_blob(hb_face_t *face);
+unsigned int hb_face_get_glyph_count(const hb_face_t *face);
+
+/* hb-font.h */
+
+typedef struct hb_font_t hb_font_t;
+
+hb_font_t *hb_font_get_empty(void);
+void hb_font_destroy(hb_font_t *font);
+
+/* hb-buffer.h */
+
+typedef struct hb_glyph_info_t {
+	hb_codepoint_t codepoint;
+	hb_mask_t mask;
+	uint32_t cluster;
+	hb_var_int_t var1;
+	hb_var_int_t var2;
+} hb_glyph_info_t;
+
+typedef struct hb_glyph_position_t {
+	hb_position_t x_advance;
+	hb_position_t y_advance;
+	hb_position_t x_offset;
+	hb_position_t y_offset;
+	hb_var_int_t var;
+} hb_glyph_position_t;
+
+typedef struct hb_buffer_t hb_buffer_t;
+
+hb_buffer_t *hb_buffer_create(void);
+void hb_buffer_destroy(hb_buffer_t *buffer);
+hb_bool_t hb_buffer_allocation_successful(hb_buffer_t *buffer);
+void hb_buffer_add_utf8(hb_buffer_t *buffer, const char *text, int text_length, unsigned int item_offset, int item_length);
+void hb_buffer_set_direction(hb_buffer_t *buffer, hb_direction_t direction);
+void hb_buffer_set_script(hb_buffer_t *buffer, hb_script_t script);
+void hb_buffer_set_language(hb_buffer_t *buffer, hb_language_t language);
+void hb_buffer_guess_segment_properties(hb_buffer_t *buffer);
+hb_glyph_info_t *hb_buffer_get_glyph_infos(hb_buffer_t *buffer, unsigned int *length);
+hb_glyph_position_t *hb_buffer_get_glyph_positions(hb_buffer_t *buffer, unsigned int *length);
+
+/* hb-shape.h */
+
+void hb_shape(hb_font_t *font, hb_buffer_t *buffer, const hb_feature_t *features, unsigned int num_features);
+
+/* hb-ft.h */
+
+hb_font_t *hb_ft_font_create_referenced(FT_Face ft_face);
+void hb_ft_font_changed(hb_font_t *font);
+void hb_ft_font_set_load_flags(hb_font_t *font, int load_flags);
+int hb_ft_font_get_load_flags(hb_font_t *font);
+
+#endif
@@ -0,0 +1,26 @@
+/*
+ * Declarations of the parts of the HarfBuzz subset API used by the bindings,
+ * for the static builds, see harfbuzz.h.
+ *
+ * See https://harfbuzz.github.io/harfbuzz-hb-subset.html
+ */
+
+#ifndef FREETYPE2_HARFBUZZ_SUBSET_H
+#define FREETYPE2_HARFBUZZ_SUBSET_H
+
+#include "harfbuzz.h"
+
+typedef struct hb_subset_input_t hb_subset_input_t;
+
+hb_subset_input_t *hb_subset_input_create_or_fail(void);
+void hb_subset_input_destroy(hb_subset_input_t *subset_input);
+hb_set_t *hb_subset_input_unicode_set(hb_subset_input_t *subset_input);
+hb_set_t *hb_subset_input_glyph_set(hb_subset_input_t *subset_input);
+hb_set_t *hb_subset_input_nameid_set(hb_subset_input_t *subset_input);
+hb_set_t *hb_subset_input_drop_tables_set(hb_subset_input_t *subset_input);
+void hb_subset_input_set_drop_hints(hb_subset_input_t *subset_input, hb_bool_t drop_hints);
+void hb_subset_input_set_retain_gids(hb_subset_input_t *subset_input, hb_bool_t retain_gids);
+
+hb_face_t *hb_subset(hb_face_t *source, hb_subset_input_t *input);
+
+#endif
@@ -0,0 +1,890 @@
+package layout
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Direction is the direction of text.
+type Direction int
+
+const (
+	// DirectionAuto selects the direction of a paragraph from its first strong
+	// character, following rules P2 and P3 of UAX #9. Paragraphs without
+	// strong characters are left to right.
+	DirectionAuto Direction = iota
+	// DirectionLTR is used for text displayed from left to right.
+	DirectionLTR
+	// DirectionRTL is used for text displayed from right to left.
+	DirectionRTL
+)
+
+func (d Direction) String() string {
+	switch d {
+	case DirectionAuto:
+		return "Auto"
+	case DirectionLTR:
+		return "LTR"
+	case DirectionRTL:
+		return "RTL"
+	default:
+		return "Unknown"
+	}
+}
+
+// bidiClass is a bidirectional character type of UAX #9.
+type bidiClass uint8
+
+const (
+	bidiL   bidiClass = iota // Left-to-Right, the default
+	bidiAL                   // Right-to-Left Arabic
+	bidiAN                   // Arabic Number
+	bidiB                    // Paragraph Separator
+	bidiBN                   // Boundary Neutral
+	bidiCS                   // Common Number Separator
+	bidiEN                   // European Number
+	bidiES                   // European Number Separator
+	bidiET                   // European Number Terminator
+	bidiFSI                  // First Strong Isolate
+	bidiLRE                  // Left-to-Right Embedding
+	bidiLRI                  // Left-to-Right Isolate
+	bidiLRO                  // Left-to-Right Override
+	bidiNSM                  // Nonspacing Mark
+	bidiON                   // Other Neutrals
+	bidiPDF                  // Pop Directional Format
+	bidiPDI                  // Pop Directional Isolate
+	bidiR                    // Right-to-Left
+	bidiRLE                  // Right-to-Left Embedding
+	bidiRLI                  // Right-to-Left Isolate
+	bidiRLO                  // Right-to-Left Override
+	bidiS                    // Segment Separator
+	bidiWS                   // Whitespace
+)
+
+type bidiRange struct {
+	lo, hi rune
+	class  bidiClass
+}
+
+type bidiMirror struct {
+	r, mirror rune
+}
+
+type bidiBracket struct {
+	r, pair rune
+	open    bool
+}
+
+func bidiClassOf(r rune) bidiClass {
+	i := sort.Search(len(bidiClasses), func(i int) bool { return bidiClasses[i].hi >= r })
+	if i < len(bidiClasses) && bidiClasses[i].lo <= r {
+		return bidiClasses[i].class
+	}
+	return bidiL
+}
+
+// Mirror returns the mirrored form of r, which is used to display it in right
+// to left text, as defined by the Bidi_Mirroring_Glyph property. It returns
+// false if r has no mirrored form.
+//
+// See https://www.unicode.org/reports/tr9/#Mirroring
+func Mirror(r rune) (rune, bool) {
+	i := sort.Search(len(bidiMirrors), func(i int) bool { return bidiMirrors[i].r >= r })
+	if i < len(bidiMirrors) && bidiMirrors[i].r == r {
+		return bidiMirrors[i].mirror, true
+	}
+	return r, false
+}
+
+// bracketOf returns the bracket properties of r, with canonically equivalent
+// brackets mapped to the same value.
+func bracketOf(r rune) (b bidiBracket, ok bool) {
+	i := sort.Search(len(bidiBrackets), func(i int) bool { return bidiBrackets[i].r >= r })
+	if i >= len(bidiBrackets) || bidiBrackets[i].r != r {
+		return bidiBracket{}, false
+	}
+
+	b = bidiBrackets[i]
+	b.r, b.pair = canonicalBracket(b.r), canonicalBracket(b.pair)
+	return b, true
+}
+
+// canonicalBracket maps the angle brackets to their canonical decomposition,
+// the only brackets having one.
+func canonicalBracket(r rune) rune {
+	switch r {
+	case 0x2329:
+		return 0x3008
+	case 0x232a:
+		return 0x3009
+	}
+	return r
+}
+
+// maxDepth is the maximum explicit embedding level.
+const maxDepth = 125
+
+// Paragraph is a paragraph of text whose embedding levels are resolved with
+// the Unicode Bidirectional Algorithm, UAX #9.
+//
+// See https://www.unicode.org/reports/tr9/
+type Paragraph struct {
+	text  string
+	level uint8
+
+	// the byte offset, original class and resolved level of every rune.
+	offsets []int
+	classes []bidiClass
+	levels  []uint8
+}
+
+// NewParagraph resolves the embedding levels of a paragraph. A paragraph
+// separator, like a line feed, should only appear at the end of text: the
+// paragraphs of longer text must be split by the caller, see Paragraphs.
+//
+// Unknown directions are treated as DirectionAuto.
+func NewParagraph(text string, dir Direction) *Paragraph {
+	p := &Paragraph{text: text}
+	for i, r := range text {
+		p.offsets = append(p.offsets, i)
+		p.classes = append(p.classes, bidiClassOf(r))
+	}
+
+	switch dir {
+	case DirectionLTR:
+		p.level = 0
+	case DirectionRTL:
+		p.level = 1
+	default:
+		p.level = p.firstStrong(0, len(p.classes))
+	}
+
+	p.resolve()
+	return p
+}
+
+// Paragraphs splits text after its paragraph separators, following rule P1.
+// A carriage return followed by a line feed is a single separator.
+func Paragraphs(text string) []string {
+	var ret []string
+
+	start := 0
+	for i, r := range text {
+		if bidiClassOf(r) != bidiB || r == '\r' && i+1 < len(text) && text[i+1] == '\n' {
+			continue
+		}
+		end := i + utf8.RuneLen(r)
+		ret = append(ret, text[start:end])
+		start = end
+	}
+	if start < len(text) || len(ret) == 0 {
+		ret = append(ret, text[start:])
+	}
+	return ret
+}
+
+// Direction returns the base direction of the paragraph, DirectionLTR or
+// DirectionRTL.
+func (p *Paragraph) Direction() Direction {
+	if p.level%2 == 1 {
+		return DirectionRTL
+	}
+	return DirectionLTR
+}
+
+// Levels returns the embedding level of every rune of text[start:end], a line
+// of the paragraph, after rule L1 resets the trailing white space to the
+// paragraph level. Odd levels are right to left.
+//
+// Explicit formatting characters, which are ignored by the algorithm, take the
+// level of the preceding rune.
+func (p *Paragraph) Levels(start, end int) []int {
+	lo := sort.SearchInts(p.offsets, start)
+	hi := sort.SearchInts(p.offsets, end)
+
+	ret := make([]int, hi-lo)
+	for i := range ret {
+		ret[i] = int(p.levels[lo+i])
+	}
+
+	// L1: segment and paragraph separators, and the white space and
+	// isolates before them or at the end of the line.
+	trailing := true
+	for i := len(ret) - 1; i >= 0; i-- {
+		switch p.classes[lo+i] {
+		case bidiS, bidiB:
+			ret[i] = int(p.level)
+			trailing = true
+		case bidiWS, bidiFSI, bidiLRI, bidiRLI, bidiPDI, bidiBN, bidiLRE, bidiRLE, bidiLRO, bidiRLO, bidiPDF:
+			if trailing {
+				ret[i] = int(p.level)
+			}
+		default:
+			trailing = false
+		}
+	}
+	return ret
+}
+
+// A Run is a sequence of text with the same embedding level.
+type Run struct {
+	// The byte range of the run, End being exclusive.
+	Start, End int
+	// The embedding level of the run, odd levels are right to left.
+	Level int
+}
+
+// Direction returns the direction of the run, DirectionLTR or DirectionRTL.
+func (r Run) Direction() Direction {
+	if r.Level%2 == 1 {
+		return DirectionRTL
+	}
+	return DirectionLTR
+}
+
+// Runs returns the runs of text[start:end], a line of the paragraph, in
+// visual order, from left to right. The runes of a right to left run are
+// displayed in reverse order.
+func (p *Paragraph) Runs(start, end int) []Run {
+	levels := p.Levels(start, end)
+	lo := sort.SearchInts(p.offsets, start)
+
+	var runs []Run
+	for i := 0; i < len(levels); {
+		j := i + 1
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+
+		runEnd := end
+		if lo+j < len(p.offsets) && p.offsets[lo+j] < end {
+			runEnd = p.offsets[lo+j]
+		}
+		runs = append(runs, Run{Start: p.offsets[lo+i], End: runEnd, Level: levels[i]})
+		i = j
+	}
+
+	levels = levels[:0]
+	for _, r := range runs {
+		levels = append(levels, r.Level)
+	}
+	order := Reorder(levels)
+
+	var ret []Run
+	for i := range runs {
+		ret = append(ret, runs[order.Logical(i)])
+	}
+	return ret
+}
+
+// Ordering maps the indices of a sequence between logical and visual order.
+type Ordering struct {
+	logical []int // the logical index of every visual index.
+	visual  []int // the visual index of every logical index.
+}
+
+// Reorder returns the visual order of a sequence with the given embedding
+// levels, reversing every sequence at or above every odd level, from the
+// highest level down, following rule L2.
+func Reorder(levels []int) Ordering {
+	o := Ordering{
+		logical: make([]int, len(levels)),
+		visual:  make([]int, len(levels)),
+	}
+
+	highest, lowestOdd := 0, maxDepth+2
+	for i, l := range levels {
+		o.logical[i] = i
+		if l > highest {
+			highest = l
+		}
+		if l%2 == 1 && l < lowestOdd {
+			lowestOdd = l
+		}
+	}
+
+	for level := highest; level >= lowestOdd; level-- {
+		for i := 0; i < len(levels); {
+			if levels[o.logical[i]] < level {
+				i++
+				continue
+			}
+			j := i + 1
+			for j < len(levels) && levels[o.logical[j]] >= level {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				o.logical[a], o.logical[b] = o.logical[b], o.logical[a]
+			}
+			i = j
+		}
+	}
+
+	for v, l := range o.logical {
+		o.visual[l] = v
+	}
+	return o
+}
+
+// Len returns the length of the sequence.
+func (o Ordering) Len() int { return len(o.logical) }
+
+// Logical returns the logical index of the item displayed at the visual index
+// i.
+func (o Ordering) Logical(i int) int { return o.logical[i] }
+
+// Visual returns the visual index of the item at the logical index i.
+func (o Ordering) Visual(i int) int { return o.visual[i] }
+
+// firstStrong returns the level of the first strong character of
+// classes[start:end], skipping isolates, following rules P2 and P3. It
+// returns 0 if there is none.
+func (p *Paragraph) firstStrong(start, end int) uint8 {
+	isolates := 0
+	for i := start; i < end; i++ {
+		switch p.classes[i] {
+		case bidiL:
+			if isolates == 0 {
+				return 0
+			}
+		case bidiR, bidiAL:
+			if isolates == 0 {
+				return 1
+			}
+		case bidiLRI, bidiRLI, bidiFSI:
+			isolates++
+		case bidiPDI:
+			if isolates > 0 {
+				isolates--
+			}
+		case bidiB:
+			return 0
+		}
+	}
+	return 0
+}
+
+// removed reports whether rule X9 removes a class.
+func removed(c bidiClass) bool {
+	switch c {
+	case bidiRLE, bidiLRE, bidiRLO, bidiLRO, bidiPDF, bidiBN:
+		return true
+	}
+	return false
+}
+
+func isIsolateInitiator(c bidiClass) bool {
+	return c == bidiLRI || c == bidiRLI || c == bidiFSI
+}
+
+// resolve resolves the embedding levels of the paragraph.
+func (p *Paragraph) resolve() {
+	n := len(p.classes)
+	p.levels = make([]uint8, n)
+	if n == 0 {
+		return
+	}
+
+	// BD9: the matching PDI of every isolate initiator.
+	matching := make([]int, n)
+	var openers []int
+	for i, c := range p.classes {
+		matching[i] = -1
+		switch {
+		case isIsolateInitiator(c):
+			openers = append(openers, i)
+		case c == bidiPDI && len(openers) > 0:
+			matching[openers[len(openers)-1]] = i
+			matching[i] = openers[len(openers)-1]
+			openers = openers[:len(openers)-1]
+		}
+	}
+
+	// the classes after the explicit rules, which apply overrides.
+	types := make([]bidiClass, n)
+	copy(types, p.classes)
+	p.explicit(types, matching)
+
+	for _, seq := range p.sequences(types, matching) {
+		seq.resolveWeak()
+		seq.resolveBrackets(p.text, p.offsets, p.classes)
+		seq.resolveNeutral()
+		seq.resolveImplicit(p.levels)
+	}
+
+	// removed characters take the level of the preceding character.
+	for i, c := range p.classes {
+		if !removed(c) {
+			continue
+		}
+		if i == 0 {
+			p.levels[i] = p.level
+		} else {
+			p.levels[i] = p.levels[i-1]
+		}
+	}
+}
+
+// explicit applies rules X1 to X8, setting the explicit embedding level of
+// every rune, and the class imposed by directional overrides.
+func (p *Paragraph) explicit(types []bidiClass, matching []int) {
+	type status struct {
+		level    uint8
+		override bidiClass // bidiON if there is no override.
+		isolate  bool
+	}
+	stack := []status{{level: p.level, override: bidiON}}
+	overflowIsolates, overflowEmbeddings, validIsolates := 0, 0, 0
+
+	// nextLevel returns the least odd or even level greater than the current
+	// one.
+	nextLevel := func(rtl bool) uint8 {
+		l := stack[len(stack)-1].level + 1
+		if rtl != (l%2 == 1) {
+			l++
+		}
+		return l
+	}
+
+	for i, c := range p.classes {
+		top := stack[len(stack)-1]
+
+		switch c {
+		case bidiRLE, bidiLRE, bidiRLO, bidiLRO:
+			p.levels[i] = top.level
+
+			l := nextLevel(c == bidiRLE || c == bidiRLO)
+			if l <= maxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				s := status{level: l, override: bidiON}
+				switch c {
+				case bidiRLO:
+					s.override = bidiR
+				case bidiLRO:
+					s.override = bidiL
+				}
+				stack = append(stack, s)
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings++
+			}
+
+		case bidiRLI, bidiLRI, bidiFSI:
+			p.levels[i] = top.level
+			if top.override != bidiON {
+				types[i] = top.override
+			}
+
+			rtl := c == bidiRLI
+			if c == bidiFSI {
+				end := matching[i]
+				if end < 0 {
+					end = len(p.classes)
+				}
+				rtl = p.firstStrong(i+1, end) == 1
+			}
+
+			l := nextLevel(rtl)
+			if l <= maxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				validIsolates++
+				stack = append(stack, status{level: l, override: bidiON, isolate: true})
+			} else {
+				overflowIsolates++
+			}
+
+		case bidiPDI:
+			switch {
+			case overflowIsolates > 0:
+				overflowIsolates--
+			case validIsolates > 0:
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+
+			top = stack[len(stack)-1]
+			p.levels[i] = top.level
+			if top.override != bidiON {
+				types[i] = top.override
+			}
+
+		case bidiPDF:
+			p.levels[i] = top.level
+
+			switch {
+			case overflowIsolates > 0:
+			case overflowEmbeddings > 0:
+				overflowEmbeddings--
+			case !top.isolate && len(stack) >= 2:
+				stack = stack[:len(stack)-1]
+			}
+
+		case bidiB:
+			// X8: the paragraph separator ends every embedding.
+			p.levels[i] = p.level
+
+		case bidiBN:
+			p.levels[i] = top.level
+
+		default:
+			p.levels[i] = top.level
+			if top.override != bidiON {
+				types[i] = top.override
+			}
+		}
+	}
+}
+
+// isolatingRunSequence is a sequence of level runs, resolved together by the
+// rules W1 to I2.
+type isolatingRunSequence struct {
+	indices  []int       // the index of every rune of the sequence.
+	types    []bidiClass // the resolved class of every rune.
+	level    uint8
+	sos, eos bidiClass
+}
+
+// sequences returns the isolating run sequences of the paragraph, following
+// rules X9 and X10.
+func (p *Paragraph) sequences(types []bidiClass, matching []int) []*isolatingRunSequence {
+	// the level runs, ignoring removed characters.
+	var runs [][]int
+	var run []int
+	for i, c := range p.classes {
+		if removed(c) {
+			continue
+		}
+		if len(run) > 0 && p.levels[i] != p.levels[run[0]] {
+			runs = append(runs, run)
+			run = nil
+		}
+		run = append(run, i)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+
+	// runStarting maps the first rune of every level run to it.
+	runStarting := make(map[int]int, len(runs))
+	for i, r := range runs {
+		runStarting[r[0]] = i
+	}
+
+	var ret []*isolatingRunSequence
+	for _, r := range runs {
+		// runs starting with a PDI matching an initiator continue the
+		// sequence of that initiator.
+		if first := r[0]; p.classes[first] == bidiPDI && matching[first] >= 0 {
+			continue
+		}
+
+		var indices []int
+		for {
+			indices = append(indices, r...)
+			last := r[len(r)-1]
+			if !isIsolateInitiator(p.classes[last]) || matching[last] < 0 {
+				break
+			}
+			next, ok := runStarting[matching[last]]
+			if !ok {
+				break
+			}
+			r = runs[next]
+		}
+
+		seq := &isolatingRunSequence{
+			indices: indices,
+			types:   make([]bidiClass, len(indices)),
+			level:   p.levels[indices[0]],
+		}
+		for i, idx := range indices {
+			seq.types[i] = types[idx]
+		}
+
+		// sos and eos, from the levels of the adjacent characters, ignoring
+		// removed ones.
+		prev, next := p.level, p.level
+		for i := indices[0] - 1; i >= 0; i-- {
+			if !removed(p.classes[i]) {
+				prev = p.levels[i]
+				break
+			}
+		}
+		if last := indices[len(indices)-1]; !isIsolateInitiator(p.classes[last]) {
+			for i := last + 1; i < len(p.classes); i++ {
+				if !removed(p.classes[i]) {
+					next = p.levels[i]
+					break
+				}
+			}
+		}
+		seq.sos = levelClass(max8(prev, seq.level))
+		seq.eos = levelClass(max8(next, seq.level))
+
+		ret = append(ret, seq)
+	}
+	return ret
+}
+
+func max8(a, b uint8) uint8 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// levelClass returns the strong class of the direction of a level.
+func levelClass(level uint8) bidiClass {
+	if level%2 == 1 {
+		return bidiR
+	}
+	return bidiL
+}
+
+// resolveWeak applies rules W1 to W7.
+func (s *isolatingRunSequence) resolveWeak() {
+	t := s.types
+
+	// W1
+	for i, c := range t {
+		if c != bidiNSM {
+			continue
+		}
+		switch {
+		case i == 0:
+			t[i] = s.sos
+		case isIsolateInitiator(t[i-1]) || t[i-1] == bidiPDI:
+			t[i] = bidiON
+		default:
+			t[i] = t[i-1]
+		}
+	}
+
+	// W2, W3
+	strong := s.sos
+	for i, c := range t {
+		switch c {
+		case bidiL, bidiR:
+			strong = c
+		case bidiAL:
+			strong = c
+			t[i] = bidiR
+		case bidiEN:
+			if strong == bidiAL {
+				t[i] = bidiAN
+			}
+		}
+	}
+
+	// W4
+	for i := 1; i < len(t)-1; i++ {
+		switch {
+		case t[i] == bidiES && t[i-1] == bidiEN && t[i+1] == bidiEN:
+			t[i] = bidiEN
+		case t[i] == bidiCS && t[i-1] == bidiEN && t[i+1] == bidiEN:
+			t[i] = bidiEN
+		case t[i] == bidiCS && t[i-1] == bidiAN && t[i+1] == bidiAN:
+			t[i] = bidiAN
+		}
+	}
+
+	// W5
+	for i := 0; i < len(t); {
+		if t[i] != bidiET {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(t) && t[j] == bidiET {
+			j++
+		}
+		if i > 0 && t[i-1] == bidiEN || j < len(t) && t[j] == bidiEN {
+			for k := i; k < j; k++ {
+				t[k] = bidiEN
+			}
+		}
+		i = j
+	}
+
+	// W6
+	for i, c := range t {
+		if c == bidiES || c == bidiET || c == bidiCS {
+			t[i] = bidiON
+		}
+	}
+
+	// W7
+	strong = s.sos
+	for i, c := range t {
+		switch c {
+		case bidiL, bidiR:
+			strong = c
+		case bidiEN:
+			if strong == bidiL {
+				t[i] = bidiL
+			}
+		}
+	}
+}
+
+// maxBrackets is the size of the bracket stack of rule BD16.
+const maxBrackets = 63
+
+// resolveBrackets applies rule N0 to the bracket pairs of the sequence.
+func (s *isolatingRunSequence) resolveBrackets(text string, offsets []int, classes []bidiClass) {
+	t := s.types
+
+	// BD16
+	type opener struct {
+		pair rune
+		pos  int
+	}
+	var stack []opener
+	var pairs [][2]int
+loop:
+	for i, idx := range s.indices {
+		if t[i] != bidiON {
+			continue
+		}
+		r, _ := utf8.DecodeRuneInString(text[offsets[idx]:])
+		b, ok := bracketOf(r)
+		if !ok {
+			continue
+		}
+
+		if b.open {
+			if len(stack) == maxBrackets {
+				break loop
+			}
+			stack = append(stack, opener{pair: b.pair, pos: i})
+			continue
+		}
+		for j := len(stack) - 1; j >= 0; j-- {
+			if stack[j].pair == b.r {
+				pairs = append(pairs, [2]int{stack[j].pos, i})
+				stack = stack[:j]
+				break
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i][0] < pairs[j][0] })
+
+	// strongOf returns the strong direction of a class, numbers being right
+	// to left, or bidiON.
+	strongOf := func(c bidiClass) bidiClass {
+		switch c {
+		case bidiL:
+			return bidiL
+		case bidiR, bidiEN, bidiAN:
+			return bidiR
+		}
+		return bidiON
+	}
+
+	e := levelClass(s.level)
+	for _, pair := range pairs {
+		open, close := pair[0], pair[1]
+
+		found := bidiON
+		for i := open + 1; i < close; i++ {
+			if d := strongOf(t[i]); d == e {
+				found = e
+				break
+			} else if d != bidiON {
+				found = d
+			}
+		}
+
+		switch found {
+		case bidiON:
+			continue
+		case e:
+		default:
+			// the opposite direction is only used if the context before
+			// the opening bracket has it too.
+			context := s.sos
+			for i := open - 1; i >= 0; i-- {
+				if d := strongOf(t[i]); d != bidiON {
+					context = d
+					break
+				}
+			}
+			if context != found {
+				found = e
+			}
+		}
+
+		t[open], t[close] = found, found
+		// nonspacing marks following the brackets take their type.
+		for _, i := range [2]int{open, close} {
+			for i++; i < len(t) && classes[s.indices[i]] == bidiNSM; i++ {
+				t[i] = found
+			}
+		}
+	}
+}
+
+// resolveNeutral applies rules N1 and N2.
+func (s *isolatingRunSequence) resolveNeutral() {
+	t := s.types
+
+	isNeutral := func(c bidiClass) bool {
+		switch c {
+		case bidiB, bidiS, bidiWS, bidiON, bidiFSI, bidiLRI, bidiRLI, bidiPDI:
+			return true
+		}
+		return false
+	}
+	strongOf := func(c bidiClass) bidiClass {
+		if c == bidiL {
+			return bidiL
+		}
+		return bidiR // R, EN and AN
+	}
+
+	e := levelClass(s.level)
+	for i := 0; i < len(t); {
+		if !isNeutral(t[i]) {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(t) && isNeutral(t[j]) {
+			j++
+		}
+
+		before, after := s.sos, s.eos
+		if i > 0 {
+			before = strongOf(t[i-1])
+		}
+		if j < len(t) {
+			after = strongOf(t[j])
+		}
+
+		resolved := e
+		if before == after {
+			resolved = before
+		}
+		for k := i; k < j; k++ {
+			t[k] = resolved
+		}
+		i = j
+	}
+}
+
+// resolveImplicit applies rules I1 and I2, setting the resolved levels.
+func (s *isolatingRunSequence) resolveImplicit(levels []uint8) {
+	for i, idx := range s.indices {
+		l := levels[idx]
+		switch c := s.types[i]; {
+		case l%2 == 0 && c == bidiR:
+			l++
+		case l%2 == 0 && (c == bidiAN || c == bidiEN):
+			l += 2
+		case l%2 == 1 && (c == bidiL || c == bidiEN || c == bidiAN):
+			l++
+		}
+		levels[idx] = l
+	}
+}
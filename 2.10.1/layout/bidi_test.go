@@ -0,0 +1,186 @@
+package layout
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDirection_String(t *testing.T) {
+	tests := []struct {
+		name string
+		x    Direction
+		want string
+	}{
+		{name: "Auto", x: DirectionAuto, want: "Auto"},
+		{name: "LTR", x: DirectionLTR, want: "LTR"},
+		{name: "RTL", x: DirectionRTL, want: "RTL"},
+		{name: "Unknown", x: DirectionRTL + 1, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("Direction.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMirror(t *testing.T) {
+	tests := []struct {
+		name   string
+		r      rune
+		want   rune
+		wantOk bool
+	}{
+		{name: "left parenthesis", r: '(', want: ')', wantOk: true},
+		{name: "right parenthesis", r: ')', want: '(', wantOk: true},
+		{name: "less-than sign", r: '<', want: '>', wantOk: true},
+		{name: "guillemet", r: '«', want: '»', wantOk: true},
+		{name: "angle bracket", r: '〈', want: '〉', wantOk: true},
+		{name: "letter", r: 'a', want: 'a', wantOk: false},
+		{name: "hyphen", r: '-', want: '-', wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Mirror(tt.r)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Mirror(%q) = %q, %v, want %q, %v", tt.r, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestParagraphs(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "empty", text: "", want: []string{""}},
+		{name: "single", text: "abc", want: []string{"abc"}},
+		{name: "trailing separator", text: "a\n", want: []string{"a\n"}},
+		{name: "separators", text: "a\nb\r\nc\u2029d\re", want: []string{"a\n", "b\r\n", "c\u2029", "d\r", "e"}},
+		{name: "empty paragraphs", text: "\n\n", want: []string{"\n", "\n"}},
+		{name: "line separator", text: "a\u2028b", want: []string{"a\u2028b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Paragraphs(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Paragraphs(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewParagraph(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		dir     Direction
+		wantDir Direction
+		want    []int
+	}{
+		{name: "empty", text: "", wantDir: DirectionLTR, want: []int{}},
+		{name: "neutral", text: "!?", wantDir: DirectionLTR, want: []int{0, 0}},
+		{name: "neutral rtl", text: "!?", dir: DirectionRTL, wantDir: DirectionRTL, want: []int{1, 1}},
+		{name: "ltr", text: "abc אבג", wantDir: DirectionLTR, want: []int{0, 0, 0, 0, 1, 1, 1}},
+		{name: "rtl", text: "אבג abc", wantDir: DirectionRTL, want: []int{1, 1, 1, 1, 2, 2, 2}},
+		{name: "forced ltr", text: "אבג abc", dir: DirectionLTR, wantDir: DirectionLTR, want: []int{1, 1, 1, 0, 0, 0, 0}},
+		{name: "european numbers", text: "אב 123", wantDir: DirectionRTL, want: []int{1, 1, 1, 2, 2, 2}},
+		{name: "leading numbers", text: "123 אב", wantDir: DirectionRTL, want: []int{2, 2, 2, 1, 1, 1}},
+		{name: "arabic numbers", text: "ب ١٢", wantDir: DirectionRTL, want: []int{1, 1, 2, 2}},
+		{name: "expression", text: "1+2=3", dir: DirectionRTL, wantDir: DirectionRTL, want: []int{2, 2, 2, 1, 2}},
+		{name: "brackets", text: "אב(c)", wantDir: DirectionRTL, want: []int{1, 1, 1, 2, 1}},
+		{name: "brackets context", text: "a(b)ג", dir: DirectionRTL, wantDir: DirectionRTL, want: []int{2, 2, 2, 2, 1}},
+		{name: "isolate", text: "\u2067אב\u2069 abc", wantDir: DirectionLTR, want: []int{0, 1, 1, 0, 0, 0, 0, 0}},
+		{name: "override", text: "a\u202eb c\u202cd", wantDir: DirectionLTR, want: []int{0, 0, 1, 1, 1, 1, 0}},
+		{name: "trailing white space", text: "abc אב  ", wantDir: DirectionLTR, want: []int{0, 0, 0, 0, 1, 1, 0, 0}},
+		{name: "segment separator", text: "אב\tcd", dir: DirectionLTR, wantDir: DirectionLTR, want: []int{1, 1, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParagraph(tt.text, tt.dir)
+			if got := p.Direction(); got != tt.wantDir {
+				t.Errorf("Paragraph.Direction() = %v, want %v", got, tt.wantDir)
+			}
+			if got := p.Levels(0, len(tt.text)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Paragraph.Levels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParagraph_Levels_lines(t *testing.T) {
+	// "abc " ends the first line, its space takes the paragraph level.
+	text := "אבג abc דה"
+	p := NewParagraph(text, DirectionAuto)
+
+	if got, want := p.Levels(0, 11), []int{1, 1, 1, 1, 2, 2, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Paragraph.Levels(0, 11) = %v, want %v", got, want)
+	}
+	if got, want := p.Levels(11, len(text)), []int{1, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Paragraph.Levels(11, %d) = %v, want %v", len(text), got, want)
+	}
+}
+
+func TestParagraph_Runs(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		dir  Direction
+		want []Run
+	}{
+		{name: "empty", text: "", want: nil},
+		{name: "ltr", text: "abc", want: []Run{{Start: 0, End: 3, Level: 0}}},
+		{name: "mixed ltr", text: "abc אבג", want: []Run{{Start: 0, End: 4, Level: 0}, {Start: 4, End: 10, Level: 1}}},
+		{name: "mixed rtl", text: "אבג abc", want: []Run{{Start: 7, End: 10, Level: 2}, {Start: 0, End: 7, Level: 1}}},
+		{name: "expression", text: "1+2=3", dir: DirectionRTL, want: []Run{{Start: 4, End: 5, Level: 2}, {Start: 3, End: 4, Level: 1}, {Start: 0, End: 3, Level: 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewParagraph(tt.text, tt.dir).Runs(0, len(tt.text)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Paragraph.Runs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_Direction(t *testing.T) {
+	if got := (Run{Level: 0}).Direction(); got != DirectionLTR {
+		t.Errorf("Run.Direction() = %v, want %v", got, DirectionLTR)
+	}
+	if got := (Run{Level: 3}).Direction(); got != DirectionRTL {
+		t.Errorf("Run.Direction() = %v, want %v", got, DirectionRTL)
+	}
+}
+
+func TestReorder(t *testing.T) {
+	tests := []struct {
+		name   string
+		levels []int
+		want   []int // the logical index of every visual index
+	}{
+		{name: "empty", levels: []int{}, want: []int{}},
+		{name: "ltr", levels: []int{0, 0, 0}, want: []int{0, 1, 2}},
+		{name: "rtl", levels: []int{1, 1, 1}, want: []int{2, 1, 0}},
+		{name: "embedded rtl", levels: []int{0, 0, 1, 1, 1, 0}, want: []int{0, 1, 4, 3, 2, 5}},
+		{name: "embedded ltr", levels: []int{1, 1, 2, 2, 1}, want: []int{4, 2, 3, 1, 0}},
+		{name: "numbers", levels: []int{2, 2, 0, 2}, want: []int{0, 1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := Reorder(tt.levels)
+			if o.Len() != len(tt.want) {
+				t.Fatalf("Ordering.Len() = %d, want %d", o.Len(), len(tt.want))
+			}
+			for v, l := range tt.want {
+				if got := o.Logical(v); got != l {
+					t.Errorf("Ordering.Logical(%d) = %d, want %d", v, got, l)
+				}
+				if got := o.Visual(l); got != v {
+					t.Errorf("Ordering.Visual(%d) = %d, want %d", l, got, v)
+				}
+			}
+		})
+	}
+}
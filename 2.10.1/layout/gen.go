@@ -0,0 +1,256 @@
+// +build ignore
+
+// gen generates tables.go from the Unicode Character Database.
+//
+// Usage:
+//
+//	go run gen.go [-ucd https://www.unicode.org/Public/15.0.0/ucd/]
+//
+// The -ucd flag also accepts a local directory.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var ucd = flag.String("ucd", "https://www.unicode.org/Public/15.0.0/ucd/", "UCD base URL or directory")
+
+const maxRune = 0x10ffff
+
+func main() {
+	flag.Parse()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from the Unicode Character Database; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package layout\n\n")
+
+	lineBreak(&buf)
+	bidiClass(&buf)
+	bidiMirroring(&buf)
+	bidiBrackets(&buf)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("unable to format tables: %v", err)
+	}
+	if err := ioutil.WriteFile("tables.go", src, 0644); err != nil {
+		log.Fatalf("unable to write tables: %v", err)
+	}
+}
+
+// lineBreak writes the line breaking classes, with the substitutions of rule
+// LB1 already applied. Ranges resolving to AL, the default, are omitted.
+func lineBreak(w io.Writer) {
+	values := property("LineBreak.txt", func(fields []string, comment string) string {
+		switch class := fields[0]; class {
+		case "AI", "SG", "XX":
+			return "AL"
+		case "CJ":
+			return "NS"
+		case "SA":
+			// the general category starts the comment.
+			if gc := strings.Fields(comment); len(gc) > 0 && (gc[0] == "Mn" || gc[0] == "Mc") {
+				return "CM"
+			}
+			return "AL"
+		default:
+			return class
+		}
+	})
+
+	fmt.Fprintf(w, "var lineBreakClasses = [...]lineBreakRange{\n")
+	writeRanges(w, values, "AL", "lb")
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// bidiClassAliases maps the long names of the bidi classes, used by the
+// @missing lines, to the short ones.
+var bidiClassAliases = map[string]string{
+	"Left_To_Right":           "L",
+	"Right_To_Left":           "R",
+	"Arabic_Letter":           "AL",
+	"European_Number":         "EN",
+	"European_Separator":      "ES",
+	"European_Terminator":     "ET",
+	"Arabic_Number":           "AN",
+	"Common_Separator":        "CS",
+	"Nonspacing_Mark":         "NSM",
+	"Boundary_Neutral":        "BN",
+	"Paragraph_Separator":     "B",
+	"Segment_Separator":       "S",
+	"White_Space":             "WS",
+	"Other_Neutral":           "ON",
+	"Left_To_Right_Embedding": "LRE",
+	"Left_To_Right_Override":  "LRO",
+	"Right_To_Left_Embedding": "RLE",
+	"Right_To_Left_Override":  "RLO",
+	"Pop_Directional_Format":  "PDF",
+	"Left_To_Right_Isolate":   "LRI",
+	"Right_To_Left_Isolate":   "RLI",
+	"First_Strong_Isolate":    "FSI",
+	"Pop_Directional_Isolate": "PDI",
+}
+
+// bidiClass writes the bidi classes, including the defaults of unassigned
+// code points. Ranges resolving to L, the default, are omitted.
+func bidiClass(w io.Writer) {
+	values := property("extracted/DerivedBidiClass.txt", func(fields []string, comment string) string {
+		if class, ok := bidiClassAliases[fields[0]]; ok {
+			return class
+		}
+		return fields[0]
+	})
+
+	fmt.Fprintf(w, "var bidiClasses = [...]bidiRange{\n")
+	writeRanges(w, values, "L", "bidi")
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// bidiMirroring writes the Bidi_Mirroring_Glyph property.
+func bidiMirroring(w io.Writer) {
+	fmt.Fprintf(w, "var bidiMirrors = [...]bidiMirror{\n")
+	parse("BidiMirroring.txt", func(lo, hi rune, fields []string, comment string, missing bool) {
+		if missing {
+			return
+		}
+		for r := lo; r <= hi; r++ {
+			fmt.Fprintf(w, "\t{0x%04X, 0x%s}, // %s\n", r, fields[0], comment)
+		}
+	})
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// bidiBrackets writes the Bidi_Paired_Bracket and Bidi_Paired_Bracket_Type
+// properties.
+func bidiBrackets(w io.Writer) {
+	fmt.Fprintf(w, "var bidiBrackets = [...]bidiBracket{\n")
+	parse("BidiBrackets.txt", func(lo, hi rune, fields []string, comment string, missing bool) {
+		if missing || fields[1] == "n" {
+			return
+		}
+		for r := lo; r <= hi; r++ {
+			fmt.Fprintf(w, "\t{0x%04X, 0x%s, %v}, // %s\n", r, fields[0], fields[1] == "o", comment)
+		}
+	})
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// property returns the value of a property for every code point of a UCD
+// file, applying the @missing lines first. Values are mapped with fn.
+func property(name string, fn func(fields []string, comment string) string) []string {
+	values := make([]string, maxRune+1)
+
+	// data lines override the @missing lines, wherever they appear.
+	type entry struct {
+		lo, hi rune
+		value  string
+	}
+	var entries []entry
+	parse(name, func(lo, hi rune, fields []string, comment string, missing bool) {
+		if !missing {
+			entries = append(entries, entry{lo, hi, fn(fields, comment)})
+			return
+		}
+		v := fn(fields, "")
+		for r := lo; r <= hi; r++ {
+			values[r] = v
+		}
+	})
+	for _, e := range entries {
+		for r := e.lo; r <= e.hi; r++ {
+			values[r] = e.value
+		}
+	}
+	return values
+}
+
+// writeRanges writes the ranges of code points holding the same value, as
+// prefix followed by the value. Code points holding def, or no value, are
+// omitted.
+func writeRanges(w io.Writer, values []string, def, prefix string) {
+	for lo := 0; lo <= maxRune; {
+		hi := lo
+		for hi < maxRune && values[hi+1] == values[lo] {
+			hi++
+		}
+		if v := values[lo]; v != "" && v != def {
+			fmt.Fprintf(w, "\t{0x%04X, 0x%04X, %s%s},\n", lo, hi, prefix, v)
+		}
+		lo = hi + 1
+	}
+}
+
+// parse calls fn for every line of a UCD file, with the code point range, the
+// remaining fields and the comment. Default values, declared in comments
+// starting with @missing, are reported with missing set.
+func parse(name string, fn func(lo, hi rune, fields []string, comment string, missing bool)) {
+	r := open(name)
+	defer r.Close()
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, comment, missing := s.Text(), "", false
+		if strings.HasPrefix(line, "# @missing:") {
+			line, missing = strings.TrimPrefix(line, "# @missing:"), true
+		}
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line, comment = line[:i], line[i+1:]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		lo, hi := fields[0], fields[0]
+		if i := strings.Index(fields[0], ".."); i >= 0 {
+			lo, hi = fields[0][:i], fields[0][i+2:]
+		}
+		fn(codePoint(lo), codePoint(hi), fields[1:], strings.TrimSpace(comment), missing)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatalf("unable to read %s: %v", name, err)
+	}
+}
+
+func codePoint(s string) rune {
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatalf("invalid code point %q: %v", s, err)
+	}
+	return rune(v)
+}
+
+func open(name string) io.ReadCloser {
+	if !strings.HasPrefix(*ucd, "http://") && !strings.HasPrefix(*ucd, "https://") {
+		f, err := os.Open(filepath.Join(*ucd, filepath.FromSlash(name)))
+		if err != nil {
+			log.Fatalf("unable to open %s: %v", name, err)
+		}
+		return f
+	}
+
+	resp, err := http.Get(strings.TrimSuffix(*ucd, "/") + "/" + name)
+	if err != nil {
+		log.Fatalf("unable to fetch %s: %v", name, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("unable to fetch %s: %s", name, resp.Status)
+	}
+	return resp.Body
+}
@@ -0,0 +1,526 @@
+// Package layout lays out text in lines of positioned glyphs, using the
+// character map, kerning and metrics of a face.
+//
+// It doesn't shape text: every character is mapped to a single glyph, so it
+// suits scripts that don't need contextual forms, like Latin, Greek, Cyrillic
+// or Hebrew. Bidirectional text is reordered with the Unicode Bidirectional
+// Algorithm, see Paragraph.
+package layout
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	freetype2 "github.com/flga/freetype2/2.10.1"
+	"github.com/flga/freetype2/fixed"
+)
+
+// Alignment is the horizontal alignment of lines.
+type Alignment int
+
+const (
+	// AlignLeft aligns lines to the left edge.
+	AlignLeft Alignment = iota
+	// AlignCenter centers lines.
+	AlignCenter
+	// AlignRight aligns lines to the right edge.
+	AlignRight
+)
+
+func (a Alignment) String() string {
+	switch a {
+	case AlignLeft:
+		return "Left"
+	case AlignCenter:
+		return "Center"
+	case AlignRight:
+		return "Right"
+	default:
+		return "Unknown"
+	}
+}
+
+// Options controls how text is laid out. All distances are in 26.6 fractional
+// pixels.
+type Options struct {
+	// The nominal size of the text, in pixels per EM. If zero, the current size
+	// of the face is used, otherwise it is set with Face.SetCharSize.
+	Size fixed.Int26_6
+	// The width lines are wrapped to, at the break opportunities of UAX #14.
+	// Words that don't fit on their own overflow. If zero, lines only break
+	// after line terminators.
+	MaxWidth fixed.Int26_6
+	// The distance between consecutive baselines. Defaults to the height of
+	// the face, see SizeMetrics.
+	LineHeight fixed.Int26_6
+	// The distance between tab stops. Defaults to the width of 8 spaces.
+	TabWidth fixed.Int26_6
+	// The horizontal alignment of lines. Lines are aligned within MaxWidth,
+	// or within the widest line if MaxWidth is zero.
+	Align Alignment
+	// The base direction of the paragraphs of the text. By default it is
+	// selected from the first strong character of every paragraph.
+	Direction Direction
+	// The flags used to load glyphs, which select the hinting mode. Kerning
+	// is grid-fitted unless LoadNoHinting is set.
+	LoadFlags freetype2.LoadFlag
+}
+
+// Glyph is a glyph positioned in the text.
+type Glyph struct {
+	// The glyph index in the face. Characters missing from the face are
+	// mapped to freetype2.MissingGlyph.
+	Index freetype2.GlyphIndex
+	// The byte offset into the text of the character that produced this glyph.
+	Cluster int
+	// The position of the glyph's origin, relative to the top left corner of
+	// the text. Y grows downwards, and is the baseline of the glyph's line.
+	X, Y fixed.Int26_6
+	// The horizontal advance of the glyph, excluding kerning. The advance of
+	// tabs extends to the next tab stop.
+	Advance fixed.Int26_6
+	// The bidi embedding level of the character, odd levels are right to
+	// left. Characters with a mirrored form, like brackets, use the glyph of
+	// that form at odd levels if the face has one.
+	Level int
+}
+
+// Line is a line of text.
+type Line struct {
+	// The glyphs of the line in visual order, from left to right, including
+	// trailing white space. Line terminators don't produce glyphs.
+	Glyphs []Glyph
+	// Order maps the glyphs between logical order, the order of the
+	// characters in the text, and visual order, the order of Glyphs.
+	Order Ordering
+	// The base direction of the line's paragraph, DirectionLTR or
+	// DirectionRTL.
+	Direction Direction
+	// The byte range of the text covered by the line, including trailing
+	// white space and line terminators. End is exclusive.
+	Start, End int
+	// The position of the line's origin on its baseline, relative to the top
+	// left corner of the text. X is the offset applied by the alignment,
+	// rounded to integer pixels.
+	X, Y fixed.Int26_6
+	// The width of the line, excluding trailing white space. In right to left
+	// paragraphs trailing white space is displayed on the left, before X.
+	Width fixed.Int26_6
+}
+
+// Text is laid out text.
+type Text struct {
+	// The lines of text. Empty text, and text ending with a line terminator,
+	// end with an empty line.
+	Lines []Line
+	// The width of the widest line.
+	Width fixed.Int26_6
+	// The distance from the top of the text to the descender of the last line.
+	Height fixed.Int26_6
+}
+
+// Layout lays out text with the face, one glyph per character.
+//
+// Glyphs are positioned with their hinted advances and the kerning of the
+// face, if any. The LsbDelta and RsbDelta of the glyphs are used to adjust
+// the distance between glyphs, as described in freetype2.GlyphSlot, so that
+// the spacing of hinted text doesn't suffer from rounding.
+//
+// Lines are broken in logical order, then every line is reordered for
+// display following the Unicode Bidirectional Algorithm. Lines are measured
+// once reordered, as the kerning and spacing of glyphs depend on their
+// neighbours in visual order.
+//
+// Note that it loads glyphs in the face's glyph slot, and sets its size if
+// opts.Size is not zero.
+//
+// It returns freetype2.ErrInvalidFaceHandle if f is nil, and
+// freetype2.ErrInvalidArgument if opts holds negative values, an unknown
+// alignment or an unknown direction.
+func Layout(f *freetype2.Face, text string, opts Options) (*Text, error) {
+	if f == nil {
+		return nil, freetype2.ErrInvalidFaceHandle
+	}
+
+	if opts.Size < 0 || opts.MaxWidth < 0 || opts.LineHeight < 0 || opts.TabWidth < 0 {
+		return nil, freetype2.ErrInvalidArgument
+	}
+	if opts.Align < AlignLeft || opts.Align > AlignRight {
+		return nil, freetype2.ErrInvalidArgument
+	}
+	if opts.Direction < DirectionAuto || opts.Direction > DirectionRTL {
+		return nil, freetype2.ErrInvalidArgument
+	}
+
+	if opts.Size > 0 {
+		if err := f.SetCharSize(0, opts.Size, 72, 72); err != nil {
+			return nil, err
+		}
+	}
+
+	l := &layouter{
+		face:    f,
+		text:    text,
+		flags:   opts.LoadFlags,
+		kerning: f.HasFlag(freetype2.FaceFlagKerning),
+		mode:    freetype2.KerningModeDefault,
+		glyphs:  make(map[freetype2.GlyphIndex]glyphMetrics),
+	}
+	if opts.LoadFlags&freetype2.LoadNoHinting == freetype2.LoadNoHinting {
+		l.mode = freetype2.KerningModeUnfitted
+	}
+
+	l.tabWidth = opts.TabWidth
+	if l.tabWidth == 0 {
+		space, err := l.metrics(f.CharIndex(' '))
+		if err != nil {
+			return nil, err
+		}
+		l.tabWidth = 8 * space.advance
+	}
+	if l.tabWidth == 0 {
+		// the face has no space, don't loop forever looking for tab stops.
+		l.tabWidth = 64
+	}
+
+	l.resolve(opts.Direction)
+	if err := l.layout(opts.MaxWidth); err != nil {
+		return nil, err
+	}
+	for i := range l.lines {
+		if err := l.reorder(&l.lines[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	metrics := f.SizeMetrics()
+	lineHeight := opts.LineHeight
+	if lineHeight == 0 {
+		lineHeight = metrics.Height
+	}
+
+	ret := &Text{Lines: l.lines}
+	for _, line := range ret.Lines {
+		if line.Width > ret.Width {
+			ret.Width = line.Width
+		}
+	}
+
+	width := opts.MaxWidth
+	if width == 0 {
+		width = ret.Width
+	}
+
+	for i := range ret.Lines {
+		line := &ret.Lines[i]
+		line.Y = metrics.Ascender + fixed.Int26_6(i)*lineHeight
+
+		switch opts.Align {
+		case AlignCenter:
+			line.X = (width - line.Width) / 2
+		case AlignRight:
+			line.X = width - line.Width
+		}
+		line.X = (line.X + 32) &^ 63
+
+		for j := range line.Glyphs {
+			line.Glyphs[j].X += line.X
+			line.Glyphs[j].Y = line.Y
+		}
+	}
+	ret.Height = ret.Lines[len(ret.Lines)-1].Y - metrics.Descender
+
+	return ret, nil
+}
+
+type glyphMetrics struct {
+	advance            fixed.Int26_6
+	lsbDelta, rsbDelta freetype2.Pos
+}
+
+// layouter breaks text in lines, and positions the glyphs of every line from
+// its origin.
+type layouter struct {
+	face     *freetype2.Face
+	text     string
+	flags    freetype2.LoadFlag
+	kerning  bool
+	mode     freetype2.KerningMode
+	tabWidth fixed.Int26_6
+	glyphs   map[freetype2.GlyphIndex]glyphMetrics
+
+	// the paragraphs of the text, and their byte offsets.
+	paragraphs []*Paragraph
+	starts     []int
+
+	lines []Line
+	line  lineState
+}
+
+// lineState is the state of the current line, which is rolled back when a
+// word doesn't fit.
+type lineState struct {
+	glyphs []Glyph
+	start  int
+	// the pen position.
+	pen fixed.Int26_6
+	// the previous glyph, for kerning, and its RsbDelta.
+	prev    freetype2.GlyphIndex
+	prevRsb freetype2.Pos
+}
+
+func (l *layouter) layout(maxWidth fixed.Int26_6) error {
+	start := 0
+	breaks := append(LineBreaks(l.text), Break{Offset: len(l.text)})
+	for _, brk := range breaks {
+		if brk.Offset == start {
+			continue
+		}
+
+		saved := l.line
+		if err := l.appendRange(start, brk.Offset); err != nil {
+			return err
+		}
+
+		if maxWidth > 0 && len(saved.glyphs) > 0 {
+			width, err := l.width(brk.Offset)
+			if err != nil {
+				return err
+			}
+			if width > maxWidth {
+				l.line = saved
+				l.line.glyphs = l.line.glyphs[:len(saved.glyphs):len(saved.glyphs)]
+				l.endLine(start)
+				if err := l.appendRange(start, brk.Offset); err != nil {
+					return err
+				}
+			}
+		}
+
+		if brk.Mandatory || brk.Offset == len(l.text) && endsWithTerminator(l.text) {
+			l.endLine(brk.Offset)
+		}
+		start = brk.Offset
+	}
+
+	// empty text, or text ending with a line terminator, end with an empty
+	// line.
+	l.endLine(len(l.text))
+	return nil
+}
+
+// endLine appends the current line, which ends at end, and starts a new one.
+func (l *layouter) endLine(end int) {
+	l.lines = append(l.lines, Line{
+		Glyphs: l.line.glyphs,
+		Start:  l.line.start,
+		End:    end,
+	})
+	l.line = lineState{start: end}
+}
+
+// appendRange appends the glyphs of text[start:end] to the current line.
+func (l *layouter) appendRange(start, end int) error {
+	for i, r := range l.text[start:end] {
+		if isTerminator(r) {
+			continue
+		}
+		if err := l.place(&l.line, r, l.face.CharIndex(r), start+i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// place appends the glyph idx of r, found at the byte offset cluster, to a
+// line. Tabs use the space glyph and extend to the next tab stop.
+func (l *layouter) place(s *lineState, r rune, idx freetype2.GlyphIndex, cluster int) error {
+	if r == '\t' {
+		idx = l.face.CharIndex(' ')
+	}
+	m, err := l.metrics(idx)
+	if err != nil {
+		return err
+	}
+
+	if r == '\t' {
+		stop := (s.pen/l.tabWidth + 1) * l.tabWidth
+		s.glyphs = append(s.glyphs, Glyph{Index: idx, Cluster: cluster, X: s.pen, Advance: stop - s.pen})
+		s.pen = stop
+		s.prev, s.prevRsb = 0, 0
+		return nil
+	}
+
+	if l.kerning && s.prev != 0 && idx != 0 {
+		kern, err := l.face.Kern(s.prev, idx, l.mode)
+		if err != nil {
+			return err
+		}
+		s.pen += fixed.Int26_6(kern.X)
+	}
+	if len(s.glyphs) > 0 {
+		if d := s.prevRsb - m.lsbDelta; d > 32 {
+			s.pen -= 64
+		} else if d < -31 {
+			s.pen += 64
+		}
+	}
+
+	s.glyphs = append(s.glyphs, Glyph{Index: idx, Cluster: cluster, X: s.pen, Advance: m.advance})
+	s.pen += m.advance
+	s.prev, s.prevRsb = idx, m.rsbDelta
+	return nil
+}
+
+// resolve resolves the embedding levels of the paragraphs of the text.
+func (l *layouter) resolve(dir Direction) {
+	start := 0
+	for _, p := range Paragraphs(l.text) {
+		l.paragraphs = append(l.paragraphs, NewParagraph(p, dir))
+		l.starts = append(l.starts, start)
+		start += len(p)
+	}
+}
+
+// width returns the width of the current line, ending at end, in visual
+// order.
+func (l *layouter) width(end int) (fixed.Int26_6, error) {
+	line := Line{Glyphs: l.line.glyphs, Start: l.line.start, End: end}
+	if err := l.reorder(&line); err != nil {
+		return 0, err
+	}
+	return line.Width, nil
+}
+
+// reorder positions the glyphs of a line again, in visual order, and measures
+// it.
+func (l *layouter) reorder(line *Line) error {
+	// the levels of the line's runes, indexed by their byte offset. A line
+	// only spans several paragraphs if they are separated by information
+	// separators, which don't break lines.
+	levels := make([]int, line.End-line.Start)
+	for j, p := range l.paragraphs {
+		lo, hi := l.starts[j], l.starts[j]+len(p.text)
+		if lo <= line.Start && (line.Start < hi || j == len(l.paragraphs)-1) {
+			line.Direction = p.Direction()
+		}
+
+		from, to := max(lo, line.Start), min(hi, line.End)
+		if from >= to {
+			continue
+		}
+		k := 0
+		pl := p.Levels(from-lo, to-lo)
+		for o := range l.text[from:to] {
+			levels[from+o-line.Start] = pl[k]
+			k++
+		}
+	}
+
+	glyphLevels := make([]int, len(line.Glyphs))
+	for j, g := range line.Glyphs {
+		glyphLevels[j] = levels[g.Cluster-line.Start]
+	}
+	line.Order = Reorder(glyphLevels)
+
+	// trailing white space, which doesn't count in the width of the line.
+	trailing := len(line.Glyphs)
+	for trailing > 0 {
+		r, _ := utf8.DecodeRuneInString(l.text[line.Glyphs[trailing-1].Cluster:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		trailing--
+	}
+
+	s := lineState{}
+	for v := range line.Glyphs {
+		j := line.Order.Logical(v)
+		g := line.Glyphs[j]
+		r, _ := utf8.DecodeRuneInString(l.text[g.Cluster:])
+
+		idx := g.Index
+		if glyphLevels[j]%2 == 1 {
+			if m, ok := Mirror(r); ok {
+				if mi := l.face.CharIndex(m); mi != 0 {
+					idx = mi
+				}
+			}
+		}
+		if err := l.place(&s, r, idx, g.Cluster); err != nil {
+			return err
+		}
+		s.glyphs[v].Level = glyphLevels[j]
+	}
+
+	// shift the glyphs so that the text, without the trailing white
+	// space, starts at 0.
+	var left, right fixed.Int26_6
+	content := false
+	for v, g := range s.glyphs {
+		if line.Order.Logical(v) >= trailing {
+			continue
+		}
+		if !content || g.X < left {
+			left = g.X
+		}
+		if !content || g.X+g.Advance > right {
+			right = g.X + g.Advance
+		}
+		content = true
+	}
+	for v := range s.glyphs {
+		s.glyphs[v].X -= left
+	}
+	line.Glyphs = s.glyphs
+	line.Width = right - left
+	return nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// metrics returns the metrics of a glyph, loading it the first time.
+func (l *layouter) metrics(idx freetype2.GlyphIndex) (glyphMetrics, error) {
+	if m, ok := l.glyphs[idx]; ok {
+		return m, nil
+	}
+
+	if err := l.face.LoadGlyph(idx, l.flags); err != nil {
+		return glyphMetrics{}, err
+	}
+	slot := l.face.GlyphSlot()
+	m := glyphMetrics{
+		advance:  slot.Advance.X,
+		lsbDelta: slot.LsbDelta,
+		rsbDelta: slot.RsbDelta,
+	}
+	l.glyphs[idx] = m
+	return m, nil
+}
+
+// isTerminator reports whether r is a line terminator, after which rule LB4
+// or LB5 force a break.
+func isTerminator(r rune) bool {
+	switch lineBreakClassOf(r) {
+	case lbBK, lbCR, lbLF, lbNL:
+		return true
+	}
+	return false
+}
+
+func endsWithTerminator(text string) bool {
+	r, size := utf8.DecodeLastRuneInString(text)
+	return size > 0 && isTerminator(r)
+}
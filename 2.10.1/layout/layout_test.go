@@ -0,0 +1,524 @@
+package layout
+
+import (
+	"reflect"
+	"testing"
+
+	freetype2 "github.com/flga/freetype2/2.10.1"
+	"github.com/flga/freetype2/fixed"
+)
+
+// openFace opens a font of the testdata directory, the returned function
+// frees it.
+func openFace(t *testing.T, path string) (*freetype2.Face, func()) {
+	t.Helper()
+
+	l, err := freetype2.NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to create lib: %v", err)
+	}
+
+	face, err := l.NewFaceFromPath("../../testdata/"+path, 0, 0)
+	if err != nil {
+		l.Free()
+		t.Fatalf("unable to open font: %v", err)
+	}
+	return face, func() { l.Free() }
+}
+
+// advance returns the advance of r.
+func advance(t *testing.T, face *freetype2.Face, r rune, flags freetype2.LoadFlag) fixed.Int26_6 {
+	t.Helper()
+
+	if err := face.LoadChar(r, flags); err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+	return face.GlyphSlot().Advance.X
+}
+
+func TestAlignment_String(t *testing.T) {
+	tests := []struct {
+		name string
+		x    Alignment
+		want string
+	}{
+		{name: "Left", x: AlignLeft, want: "Left"},
+		{name: "Center", x: AlignCenter, want: "Center"},
+		{name: "Right", x: AlignRight, want: "Right"},
+		{name: "Unknown", x: AlignRight + 1, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("Alignment.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayout_errors(t *testing.T) {
+	face, free := openFace(t, "go/Go-Mono.ttf")
+	defer free()
+
+	if _, err := Layout(nil, "a", Options{}); err != freetype2.ErrInvalidFaceHandle {
+		t.Errorf("Layout() error = %v, want %v", err, freetype2.ErrInvalidFaceHandle)
+	}
+
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{name: "negative size", opts: Options{Size: -1}},
+		{name: "negative max width", opts: Options{Size: 16 << 6, MaxWidth: -1}},
+		{name: "negative line height", opts: Options{Size: 16 << 6, LineHeight: -1}},
+		{name: "negative tab width", opts: Options{Size: 16 << 6, TabWidth: -1}},
+		{name: "unknown alignment", opts: Options{Size: 16 << 6, Align: AlignRight + 1}},
+		{name: "unknown direction", opts: Options{Size: 16 << 6, Direction: DirectionRTL + 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Layout(face, "a", tt.opts); err != freetype2.ErrInvalidArgument {
+				t.Errorf("Layout() error = %v, want %v", err, freetype2.ErrInvalidArgument)
+			}
+		})
+	}
+}
+
+func TestLayout(t *testing.T) {
+	face, free := openFace(t, "go/Go-Mono.ttf")
+	defer free()
+	if err := face.SetCharSize(0, 16<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set size: %v", err)
+	}
+	metrics := face.SizeMetrics()
+	adv := advance(t, face, 'a', freetype2.LoadDefault)
+
+	type line struct {
+		start, end int
+		x, width   fixed.Int26_6
+		clusters   []int
+		xs         []fixed.Int26_6 // relative to the line's X
+	}
+	tests := []struct {
+		name       string
+		text       string
+		opts       Options
+		want       []line
+		wantWidth  fixed.Int26_6
+		lineHeight fixed.Int26_6
+	}{
+		{
+			name: "empty",
+			text: "",
+			want: []line{{start: 0, end: 0}},
+		},
+		{
+			name:      "word",
+			text:      "ab",
+			want:      []line{{start: 0, end: 2, width: 2 * adv, clusters: []int{0, 1}, xs: []fixed.Int26_6{0, adv}}},
+			wantWidth: 2 * adv,
+		},
+		{
+			name: "trailing white space",
+			text: "ab  ",
+			want: []line{
+				{start: 0, end: 4, width: 2 * adv, clusters: []int{0, 1, 2, 3}, xs: []fixed.Int26_6{0, adv, 2 * adv, 3 * adv}},
+			},
+			wantWidth: 2 * adv,
+		},
+		{
+			name: "trailing line terminator",
+			text: "ab\n",
+			want: []line{
+				{start: 0, end: 3, width: 2 * adv, clusters: []int{0, 1}, xs: []fixed.Int26_6{0, adv}},
+				{start: 3, end: 3},
+			},
+			wantWidth: 2 * adv,
+		},
+		{
+			name: "line terminators",
+			text: "a\r\n\nbé",
+			want: []line{
+				{start: 0, end: 3, width: adv, clusters: []int{0}, xs: []fixed.Int26_6{0}},
+				{start: 3, end: 4},
+				{start: 4, end: 7, width: 2 * adv, clusters: []int{4, 5}, xs: []fixed.Int26_6{0, adv}},
+			},
+			wantWidth: 2 * adv,
+		},
+		{
+			name: "default tab width",
+			text: "a\tb",
+			want: []line{
+				{start: 0, end: 3, width: 9 * adv, clusters: []int{0, 1, 2}, xs: []fixed.Int26_6{0, adv, 8 * adv}},
+			},
+			wantWidth: 9 * adv,
+		},
+		{
+			name: "tab width",
+			text: "a\tbcd\te",
+			opts: Options{TabWidth: 3 * adv},
+			want: []line{
+				{start: 0, end: 7, width: 10 * adv, clusters: []int{0, 1, 2, 3, 4, 5, 6}, xs: []fixed.Int26_6{0, adv, 3 * adv, 4 * adv, 5 * adv, 6 * adv, 9 * adv}},
+			},
+			wantWidth: 10 * adv,
+		},
+		{
+			name: "wrap",
+			text: "aaa bbb ccc",
+			opts: Options{MaxWidth: 7 * adv},
+			want: []line{
+				{start: 0, end: 8, width: 7 * adv, clusters: []int{0, 1, 2, 3, 4, 5, 6, 7}, xs: []fixed.Int26_6{0, adv, 2 * adv, 3 * adv, 4 * adv, 5 * adv, 6 * adv, 7 * adv}},
+				{start: 8, end: 11, width: 3 * adv, clusters: []int{8, 9, 10}, xs: []fixed.Int26_6{0, adv, 2 * adv}},
+			},
+			wantWidth: 7 * adv,
+		},
+		{
+			name: "wrap hyphen",
+			text: "aa-bb",
+			opts: Options{MaxWidth: 4 * adv},
+			want: []line{
+				{start: 0, end: 3, width: 3 * adv, clusters: []int{0, 1, 2}, xs: []fixed.Int26_6{0, adv, 2 * adv}},
+				{start: 3, end: 5, width: 2 * adv, clusters: []int{3, 4}, xs: []fixed.Int26_6{0, adv}},
+			},
+			wantWidth: 3 * adv,
+		},
+		{
+			name: "overflow",
+			text: "aaaa b",
+			opts: Options{MaxWidth: 2 * adv},
+			want: []line{
+				{start: 0, end: 5, width: 4 * adv, clusters: []int{0, 1, 2, 3, 4}, xs: []fixed.Int26_6{0, adv, 2 * adv, 3 * adv, 4 * adv}},
+				{start: 5, end: 6, width: adv, clusters: []int{5}, xs: []fixed.Int26_6{0}},
+			},
+			wantWidth: 4 * adv,
+		},
+		{
+			name: "center",
+			text: "ab\nabcd",
+			opts: Options{Align: AlignCenter},
+			want: []line{
+				{start: 0, end: 3, x: adv, width: 2 * adv, clusters: []int{0, 1}, xs: []fixed.Int26_6{0, adv}},
+				{start: 3, end: 7, width: 4 * adv, clusters: []int{3, 4, 5, 6}, xs: []fixed.Int26_6{0, adv, 2 * adv, 3 * adv}},
+			},
+			wantWidth: 4 * adv,
+		},
+		{
+			name: "right",
+			text: "ab\nabcd",
+			opts: Options{Align: AlignRight, MaxWidth: 10 * adv},
+			want: []line{
+				{start: 0, end: 3, x: 8 * adv, width: 2 * adv, clusters: []int{0, 1}, xs: []fixed.Int26_6{0, adv}},
+				{start: 3, end: 7, x: 6 * adv, width: 4 * adv, clusters: []int{3, 4, 5, 6}, xs: []fixed.Int26_6{0, adv, 2 * adv, 3 * adv}},
+			},
+			wantWidth: 4 * adv,
+		},
+		{
+			name: "line height",
+			text: "a\nb",
+			opts: Options{LineHeight: 100 << 6},
+			want: []line{
+				{start: 0, end: 2, width: adv, clusters: []int{0}, xs: []fixed.Int26_6{0}},
+				{start: 2, end: 3, width: adv, clusters: []int{2}, xs: []fixed.Int26_6{0}},
+			},
+			wantWidth:  adv,
+			lineHeight: 100 << 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Layout(face, tt.text, tt.opts)
+			if err != nil {
+				t.Fatalf("Layout() error = %v", err)
+			}
+
+			lineHeight := tt.lineHeight
+			if lineHeight == 0 {
+				lineHeight = metrics.Height
+			}
+
+			if len(got.Lines) != len(tt.want) {
+				t.Fatalf("Layout() got %d lines, want %d", len(got.Lines), len(tt.want))
+			}
+			if got.Width != tt.wantWidth {
+				t.Errorf("Layout() width = %v, want %v", got.Width, tt.wantWidth)
+			}
+			if want := metrics.Ascender + fixed.Int26_6(len(tt.want)-1)*lineHeight - metrics.Descender; got.Height != want {
+				t.Errorf("Layout() height = %v, want %v", got.Height, want)
+			}
+
+			for i, want := range tt.want {
+				l := got.Lines[i]
+				if l.Start != want.start || l.End != want.end {
+					t.Errorf("line %d range = [%d, %d), want [%d, %d)", i, l.Start, l.End, want.start, want.end)
+				}
+				if l.X != want.x || l.Width != want.width {
+					t.Errorf("line %d x, width = %v, %v, want %v, %v", i, l.X, l.Width, want.x, want.width)
+				}
+				if wantY := metrics.Ascender + fixed.Int26_6(i)*lineHeight; l.Y != wantY {
+					t.Errorf("line %d y = %v, want %v", i, l.Y, wantY)
+				}
+
+				var clusters []int
+				var xs []fixed.Int26_6
+				for _, g := range l.Glyphs {
+					clusters = append(clusters, g.Cluster)
+					xs = append(xs, g.X-l.X)
+					if g.Y != l.Y {
+						t.Errorf("line %d glyph %d y = %v, want %v", i, g.Cluster, g.Y, l.Y)
+					}
+				}
+				if !reflect.DeepEqual(clusters, want.clusters) {
+					t.Errorf("line %d clusters = %v, want %v", i, clusters, want.clusters)
+				}
+				if !reflect.DeepEqual(xs, want.xs) {
+					t.Errorf("line %d positions = %v, want %v", i, xs, want.xs)
+				}
+			}
+		})
+	}
+}
+
+func TestLayout_glyphs(t *testing.T) {
+	face, free := openFace(t, "go/Go-Mono.ttf")
+	defer free()
+
+	got, err := Layout(face, "a\tbé\U0001F600", Options{Size: 16 << 6})
+	if err != nil {
+		t.Fatalf("Layout() error = %v", err)
+	}
+
+	space := face.CharIndex(' ')
+	want := []freetype2.GlyphIndex{face.CharIndex('a'), space, face.CharIndex('b'), face.CharIndex('é'), freetype2.MissingGlyph}
+	var indices []freetype2.GlyphIndex
+	for _, g := range got.Lines[0].Glyphs {
+		indices = append(indices, g.Index)
+	}
+	if !reflect.DeepEqual(indices, want) {
+		t.Errorf("Layout() glyphs = %v, want %v", indices, want)
+	}
+}
+
+func TestLayout_kerning(t *testing.T) {
+	face, free := openFace(t, "arimo/Arimo-Regular.ttf")
+	defer free()
+	if !face.HasFlag(freetype2.FaceFlagKerning) {
+		t.Fatalf("Arimo has no kerning")
+	}
+
+	tests := []struct {
+		name  string
+		flags freetype2.LoadFlag
+		mode  freetype2.KerningMode
+	}{
+		{name: "hinted", flags: freetype2.LoadDefault, mode: freetype2.KerningModeDefault},
+		{name: "unhinted", flags: freetype2.LoadNoHinting, mode: freetype2.KerningModeUnfitted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Layout(face, "AV", Options{Size: 32 << 6, LoadFlags: tt.flags})
+			if err != nil {
+				t.Fatalf("Layout() error = %v", err)
+			}
+
+			kern, err := face.Kern(face.CharIndex('A'), face.CharIndex('V'), tt.mode)
+			if err != nil {
+				t.Fatalf("unable to get kerning: %v", err)
+			}
+			if kern.X >= 0 {
+				t.Fatalf("A V kerning = %v, want a negative value", kern.X)
+			}
+
+			want := advance(t, face, 'A', tt.flags) + fixed.Int26_6(kern.X)
+			if x := got.Lines[0].Glyphs[1].X; x != want {
+				t.Errorf("Layout() V at %v, want %v", x, want)
+			}
+		})
+	}
+}
+
+func TestLayout_deltas(t *testing.T) {
+	face, free := openFace(t, "go/Go-Regular.ttf")
+	defer free()
+	const text = "To be, or not to be: that is the question."
+
+	got, err := Layout(face, text, Options{Size: 13 << 6, LoadFlags: freetype2.LoadForceAutohint})
+	if err != nil {
+		t.Fatalf("Layout() error = %v", err)
+	}
+
+	// the integer positioning from the GlyphSlot documentation.
+	var want []fixed.Int26_6
+	var x fixed.Int26_6
+	var prevRsb freetype2.Pos
+	adjusted := false
+	for i, r := range text {
+		if err := face.LoadChar(r, freetype2.LoadForceAutohint); err != nil {
+			t.Fatalf("unable to load glyph: %v", err)
+		}
+		slot := face.GlyphSlot()
+		if i > 0 {
+			if d := prevRsb - slot.LsbDelta; d > 32 {
+				x -= 64
+				adjusted = true
+			} else if d < -31 {
+				x += 64
+				adjusted = true
+			}
+		}
+		prevRsb = slot.RsbDelta
+		want = append(want, x)
+		x += slot.Advance.X
+	}
+	if !adjusted {
+		t.Fatalf("the text needs no adjustment")
+	}
+
+	var xs []fixed.Int26_6
+	for _, g := range got.Lines[0].Glyphs {
+		xs = append(xs, g.X)
+	}
+	if !reflect.DeepEqual(xs, want) {
+		t.Errorf("Layout() positions = %v, want %v", xs, want)
+	}
+}
+
+func TestLayout_bidi(t *testing.T) {
+	face, free := openFace(t, "arimo/Arimo-Regular.ttf")
+	defer free()
+
+	idx := func(r rune) freetype2.GlyphIndex { return face.CharIndex(r) }
+
+	tests := []struct {
+		name        string
+		text        string
+		opts        Options
+		wantDir     Direction
+		wantIndices []freetype2.GlyphIndex
+		wantCluster []int
+		wantLevels  []int
+	}{
+		{
+			name:        "ltr",
+			text:        "ab אב",
+			wantDir:     DirectionLTR,
+			wantIndices: []freetype2.GlyphIndex{idx('a'), idx('b'), idx(' '), idx('ב'), idx('א')},
+			wantCluster: []int{0, 1, 2, 5, 3},
+			wantLevels:  []int{0, 0, 0, 1, 1},
+		},
+		{
+			name:        "rtl",
+			text:        "אב (c)",
+			wantDir:     DirectionRTL,
+			wantIndices: []freetype2.GlyphIndex{idx('('), idx('c'), idx(')'), idx(' '), idx('ב'), idx('א')},
+			wantCluster: []int{7, 6, 5, 4, 2, 0},
+			wantLevels:  []int{1, 2, 1, 1, 1, 1},
+		},
+		{
+			name:        "forced rtl",
+			text:        "ab",
+			opts:        Options{Direction: DirectionRTL},
+			wantDir:     DirectionRTL,
+			wantIndices: []freetype2.GlyphIndex{idx('a'), idx('b')},
+			wantCluster: []int{0, 1},
+			wantLevels:  []int{2, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Size = 16 << 6
+			got, err := Layout(face, tt.text, tt.opts)
+			if err != nil {
+				t.Fatalf("Layout() error = %v", err)
+			}
+
+			line := got.Lines[0]
+			if line.Direction != tt.wantDir {
+				t.Errorf("Layout() direction = %v, want %v", line.Direction, tt.wantDir)
+			}
+
+			var indices []freetype2.GlyphIndex
+			var clusters, levels []int
+			for v, g := range line.Glyphs {
+				indices = append(indices, g.Index)
+				clusters = append(clusters, g.Cluster)
+				levels = append(levels, g.Level)
+				if v > 0 && g.X <= line.Glyphs[v-1].X {
+					t.Errorf("Layout() glyph %d at %v, left of the previous one at %v", v, g.X, line.Glyphs[v-1].X)
+				}
+
+				// the ordering maps glyphs to the order of the text.
+				l := line.Order.Logical(v)
+				if line.Order.Visual(l) != v {
+					t.Errorf("Ordering.Visual(%d) = %d, want %d", l, line.Order.Visual(l), v)
+				}
+				if l > 0 && line.Glyphs[line.Order.Visual(l-1)].Cluster >= g.Cluster {
+					t.Errorf("glyph %d is not after glyph %d in the text", l, l-1)
+				}
+			}
+			if !reflect.DeepEqual(indices, tt.wantIndices) {
+				t.Errorf("Layout() glyphs = %v, want %v", indices, tt.wantIndices)
+			}
+			if !reflect.DeepEqual(clusters, tt.wantCluster) {
+				t.Errorf("Layout() clusters = %v, want %v", clusters, tt.wantCluster)
+			}
+			if !reflect.DeepEqual(levels, tt.wantLevels) {
+				t.Errorf("Layout() levels = %v, want %v", levels, tt.wantLevels)
+			}
+		})
+	}
+}
+
+func TestLayout_bidiTrailingWhiteSpace(t *testing.T) {
+	face, free := openFace(t, "arimo/Arimo-Regular.ttf")
+	defer free()
+
+	// the trailing space of a right to left line is displayed on its left,
+	// outside of its width.
+	got, err := Layout(face, "אב ", Options{Size: 16 << 6, Align: AlignRight, MaxWidth: 100 << 6})
+	if err != nil {
+		t.Fatalf("Layout() error = %v", err)
+	}
+
+	line := got.Lines[0]
+	space, last := line.Glyphs[0], line.Glyphs[len(line.Glyphs)-1]
+	if space.Cluster != 4 {
+		t.Fatalf("Layout() first glyph cluster = %d, want 4", space.Cluster)
+	}
+	if want := line.X - space.Advance; space.X != want {
+		t.Errorf("Layout() space at %v, want %v", space.X, want)
+	}
+	if want := line.X + line.Width; last.X+last.Advance != want {
+		t.Errorf("Layout() line ends at %v, want %v", last.X+last.Advance, want)
+	}
+	if want := fixed.Int26_6(100 << 6); line.X+line.Width != want {
+		t.Errorf("Layout() line ends at %v, want %v", line.X+line.Width, want)
+	}
+}
+
+func TestLayout_bidiMaxWidth(t *testing.T) {
+	face, free := openFace(t, "arimo/Arimo-Regular.ttf")
+	defer free()
+
+	// the period is kerned after the V in logical order, but this right to
+	// left line displays it on the left of the V, where it isn't kerned: the
+	// line is wider once reordered.
+	const text = "ד V.ב"
+	unbroken, err := Layout(face, text, Options{Size: 16 << 6})
+	if err != nil {
+		t.Fatalf("Layout() error = %v", err)
+	}
+
+	maxWidth := unbroken.Lines[0].Width - 1
+	got, err := Layout(face, text, Options{Size: 16 << 6, MaxWidth: maxWidth})
+	if err != nil {
+		t.Fatalf("Layout() error = %v", err)
+	}
+	if len(got.Lines) != 2 {
+		t.Fatalf("Layout() lines = %d, want 2", len(got.Lines))
+	}
+	for i, line := range got.Lines {
+		if line.Width > maxWidth {
+			t.Errorf("Layout() line %d width = %v, want at most %v", i, line.Width, maxWidth)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+package layout
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+//go:generate go run gen.go
+
+// lineBreakClass is a line breaking class of UAX #14, after the substitutions
+// of rule LB1.
+type lineBreakClass uint8
+
+const (
+	lbAL  lineBreakClass = iota // Alphabetic, the default
+	lbB2                        // Break Opportunity Before and After
+	lbBA                        // Break After
+	lbBB                        // Break Before
+	lbBK                        // Mandatory Break
+	lbCB                        // Contingent Break Opportunity
+	lbCL                        // Close Punctuation
+	lbCM                        // Combining Mark
+	lbCP                        // Close Parenthesis
+	lbCR                        // Carriage Return
+	lbEB                        // Emoji Base
+	lbEM                        // Emoji Modifier
+	lbEX                        // Exclamation/Interrogation
+	lbGL                        // Non-breaking ("Glue")
+	lbH2                        // Hangul LV Syllable
+	lbH3                        // Hangul LVT Syllable
+	lbHL                        // Hebrew Letter
+	lbHY                        // Hyphen
+	lbID                        // Ideographic
+	lbIN                        // Inseparable
+	lbIS                        // Infix Numeric Separator
+	lbJL                        // Hangul L Jamo
+	lbJT                        // Hangul T Jamo
+	lbJV                        // Hangul V Jamo
+	lbLF                        // Line Feed
+	lbNL                        // Next Line
+	lbNS                        // Nonstarter
+	lbNU                        // Numeric
+	lbOP                        // Open Punctuation
+	lbPO                        // Postfix Numeric
+	lbPR                        // Prefix Numeric
+	lbQU                        // Quotation
+	lbRI                        // Regional Indicator
+	lbSP                        // Space
+	lbSY                        // Symbols Allowing Break After
+	lbWJ                        // Word Joiner
+	lbZW                        // Zero Width Space
+	lbZWJ                       // Zero Width Joiner
+)
+
+type lineBreakRange struct {
+	lo, hi rune
+	class  lineBreakClass
+}
+
+func lineBreakClassOf(r rune) lineBreakClass {
+	i := sort.Search(len(lineBreakClasses), func(i int) bool { return lineBreakClasses[i].hi >= r })
+	if i < len(lineBreakClasses) && lineBreakClasses[i].lo <= r {
+		return lineBreakClasses[i].class
+	}
+	return lbAL
+}
+
+// eastAsianPunctuation reports whether an OP or CP rune is wide, which rule
+// LB30 excludes.
+func eastAsianPunctuation(r rune) bool {
+	switch {
+	case r == 0x2329 || r == 0x232a: // angle brackets
+		return true
+	case r >= 0x3000 && r <= 0x303f: // CJK symbols and punctuation
+		return true
+	case r >= 0xfe10 && r <= 0xfe1f, r >= 0xfe30 && r <= 0xfe6f: // vertical, compatibility and small forms
+		return true
+	case r >= 0xff00 && r <= 0xffef: // halfwidth and fullwidth forms
+		return true
+	}
+	return false
+}
+
+// A Break is a line break opportunity.
+type Break struct {
+	// The byte offset of the text following the break.
+	Offset int
+	// Mandatory breaks follow line terminators, and must be honoured.
+	Mandatory bool
+}
+
+// LineBreaks returns the line break opportunities of text, following the
+// default rules of UAX #14, Unicode Line Breaking Algorithm. The break at the
+// end of the text is not included.
+//
+// Rules relying on dictionaries, such as breaking Thai words, are not
+// implemented, and complex context scripts are treated as alphabetic.
+//
+// See https://www.unicode.org/reports/tr14/
+func LineBreaks(text string) []Break {
+	var ret []Break
+
+	s := lineBreaker{}
+	for i, r := range text {
+		if brk, mandatory := s.next(r, text[i+utf8.RuneLen(r):]); brk && i > 0 {
+			ret = append(ret, Break{Offset: i, Mandatory: mandatory})
+		}
+	}
+	return ret
+}
+
+// lineBreaker finds the line break opportunities of a text, one rune at a time.
+type lineBreaker struct {
+	started bool
+
+	// the class of the previous rune, after rules LB9 and LB10.
+	prev     lineBreakClass
+	prevRune rune
+	// the class before prev, used by rule LB21a.
+	prevPrev lineBreakClass
+	// the class of the last rune that is not a space.
+	beforeSpaces lineBreakClass
+	// whether the previous rune is a zero width joiner, before rule LB9.
+	zwj bool
+	// the number of consecutive regional indicators.
+	regional int
+	// whether the text matches NU (NU|SY|IS)* for rule LB25, and whether it
+	// is followed by CL or CP.
+	number, closedNumber bool
+}
+
+// next reports whether there is a break opportunity before r, which is
+// followed by rest.
+func (s *lineBreaker) next(r rune, rest string) (brk, mandatory bool) {
+	cur := lineBreakClassOf(r)
+
+	if !s.started {
+		s.started = true
+		zwj := cur == lbZWJ
+		if cur == lbCM || cur == lbZWJ {
+			cur = lbAL // LB10
+		}
+		s.prevPrev = cur
+		s.update(cur, r, zwj)
+		return false, false
+	}
+
+	// LB9: combining marks take the class of their base. The rules before it
+	// never allow a break there.
+	if cur == lbCM || cur == lbZWJ {
+		switch s.prev {
+		case lbBK, lbCR, lbLF, lbNL, lbSP, lbZW:
+		default:
+			s.zwj = cur == lbZWJ
+			return false, false
+		}
+	}
+
+	brk, mandatory = s.rule(cur, r, rest)
+	if cur == lbCM || cur == lbZWJ {
+		s.update(lbAL, r, cur == lbZWJ) // LB10
+	} else {
+		s.update(cur, r, false)
+	}
+	return brk, mandatory
+}
+
+func (s *lineBreaker) update(cur lineBreakClass, r rune, zwj bool) {
+	switch {
+	case cur == lbNU:
+		s.number, s.closedNumber = true, false
+	case s.number && (cur == lbSY || cur == lbIS):
+	case s.number && (cur == lbCL || cur == lbCP):
+		s.number, s.closedNumber = false, true
+	default:
+		s.number, s.closedNumber = false, false
+	}
+
+	if cur == lbRI {
+		s.regional++
+	} else {
+		s.regional = 0
+	}
+
+	if cur != lbSP {
+		s.beforeSpaces = cur
+	}
+	s.prevPrev, s.prev, s.prevRune, s.zwj = s.prev, cur, r, zwj
+}
+
+// rule applies the pair rules of UAX #14 to the previous rune and r, whose
+// class is cur.
+func (s *lineBreaker) rule(cur lineBreakClass, r rune, rest string) (brk, mandatory bool) {
+	prev := s.prev
+
+	switch {
+	// LB4, LB5
+	case prev == lbCR && cur == lbLF:
+		return false, false
+	case prev == lbBK || prev == lbCR || prev == lbLF || prev == lbNL:
+		return true, true
+	// LB6
+	case cur == lbBK || cur == lbCR || cur == lbLF || cur == lbNL:
+		return false, false
+	// LB7
+	case cur == lbSP || cur == lbZW:
+		return false, false
+	// LB8
+	case s.beforeSpaces == lbZW:
+		return true, false
+	// LB8a
+	case s.zwj:
+		return false, false
+	}
+
+	if cur == lbCM || cur == lbZWJ {
+		cur = lbAL // LB10
+	}
+
+	switch {
+	// LB11
+	case cur == lbWJ || prev == lbWJ:
+		return false, false
+	// LB12, LB12a
+	case prev == lbGL:
+		return false, false
+	case cur == lbGL && prev != lbSP && prev != lbBA && prev != lbHY:
+		return false, false
+	// LB13
+	case cur == lbCL || cur == lbCP || cur == lbEX || cur == lbIS || cur == lbSY:
+		return false, false
+	// LB14, LB15, LB16, LB17
+	case s.beforeSpaces == lbOP:
+		return false, false
+	case s.beforeSpaces == lbQU && cur == lbOP:
+		return false, false
+	case (s.beforeSpaces == lbCL || s.beforeSpaces == lbCP) && cur == lbNS:
+		return false, false
+	case s.beforeSpaces == lbB2 && cur == lbB2:
+		return false, false
+	// LB18
+	case prev == lbSP:
+		return true, false
+	// LB19
+	case cur == lbQU || prev == lbQU:
+		return false, false
+	// LB20
+	case cur == lbCB || prev == lbCB:
+		return true, false
+	// LB21, LB21a, LB21b
+	case cur == lbBA || cur == lbHY || cur == lbNS || prev == lbBB:
+		return false, false
+	case s.prevPrev == lbHL && (prev == lbHY || prev == lbBA):
+		return false, false
+	case prev == lbSY && cur == lbHL:
+		return false, false
+	// LB22
+	case cur == lbIN:
+		return false, false
+	// LB23, LB23a, LB24
+	case isAlpha(prev) && cur == lbNU || prev == lbNU && isAlpha(cur):
+		return false, false
+	case prev == lbPR && (cur == lbID || cur == lbEB || cur == lbEM):
+		return false, false
+	case (prev == lbID || prev == lbEB || prev == lbEM) && cur == lbPO:
+		return false, false
+	case (prev == lbPR || prev == lbPO) && isAlpha(cur):
+		return false, false
+	case isAlpha(prev) && (cur == lbPR || cur == lbPO):
+		return false, false
+	// LB25
+	case (prev == lbPR || prev == lbPO) && cur == lbNU:
+		return false, false
+	case (prev == lbPR || prev == lbPO) && (cur == lbOP || cur == lbHY) && startsWithNumber(rest):
+		return false, false
+	case (prev == lbOP || prev == lbHY) && cur == lbNU:
+		return false, false
+	case s.number && (cur == lbNU || cur == lbSY || cur == lbIS || cur == lbCL || cur == lbCP):
+		return false, false
+	case (s.number || s.closedNumber) && (cur == lbPO || cur == lbPR):
+		return false, false
+	// LB26, LB27
+	case prev == lbJL && (cur == lbJL || cur == lbJV || cur == lbH2 || cur == lbH3):
+		return false, false
+	case (prev == lbJV || prev == lbH2) && (cur == lbJV || cur == lbJT):
+		return false, false
+	case (prev == lbJT || prev == lbH3) && cur == lbJT:
+		return false, false
+	case isHangul(prev) && cur == lbPO || prev == lbPR && isHangul(cur):
+		return false, false
+	// LB28, LB29
+	case isAlpha(prev) && isAlpha(cur):
+		return false, false
+	case prev == lbIS && isAlpha(cur):
+		return false, false
+	// LB30
+	case (isAlpha(prev) || prev == lbNU) && cur == lbOP && !eastAsianPunctuation(r):
+		return false, false
+	case prev == lbCP && !eastAsianPunctuation(s.prevRune) && (isAlpha(cur) || cur == lbNU):
+		return false, false
+	// LB30a, LB30b
+	case prev == lbRI && cur == lbRI && s.regional%2 == 1:
+		return false, false
+	case prev == lbEB && cur == lbEM:
+		return false, false
+	}
+
+	// LB31
+	return true, false
+}
+
+func isAlpha(c lineBreakClass) bool { return c == lbAL || c == lbHL }
+
+func isHangul(c lineBreakClass) bool {
+	return c == lbJL || c == lbJV || c == lbJT || c == lbH2 || c == lbH3
+}
+
+// startsWithNumber reports whether text starts with a NU rune.
+func startsWithNumber(text string) bool {
+	r, size := utf8.DecodeRuneInString(text)
+	return size > 0 && lineBreakClassOf(r) == lbNU
+}
@@ -0,0 +1,51 @@
+package layout
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLineBreaks(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Break
+	}{
+		{name: "empty", text: "", want: nil},
+		{name: "word", text: "hello", want: nil},
+		{name: "space", text: "hello world", want: []Break{{Offset: 6}}},
+		{name: "spaces", text: "a  b", want: []Break{{Offset: 3}}},
+		{name: "tab", text: "a\tb", want: []Break{{Offset: 2}}},
+		{name: "no-break space", text: "a\u00a0b", want: nil},
+		{name: "word joiner", text: "a\u2060 b", want: []Break{{Offset: 5}}},
+		{name: "zero width space", text: "a\u200bb", want: []Break{{Offset: 4}}},
+		{name: "LF", text: "a\nb", want: []Break{{Offset: 2, Mandatory: true}}},
+		{name: "CR LF", text: "a\r\nb", want: []Break{{Offset: 3, Mandatory: true}}},
+		{name: "CR", text: "a\rb\n", want: []Break{{Offset: 2, Mandatory: true}}},
+		{name: "line separator", text: "a\u2028b", want: []Break{{Offset: 4, Mandatory: true}}},
+		{name: "hyphen", text: "well-known", want: []Break{{Offset: 5}}},
+		{name: "em dash", text: "a—b", want: []Break{{Offset: 1}, {Offset: 4}}},
+		{name: "hebrew hyphen", text: "אב-גד", want: nil},
+		{name: "solidus", text: "x/y", want: []Break{{Offset: 2}}},
+		{name: "abbreviation", text: "e.g. x", want: []Break{{Offset: 5}}},
+		{name: "question mark", text: "Ab?", want: nil},
+		{name: "quotation", text: "«a»", want: nil},
+		{name: "parentheses", text: "(a) b", want: []Break{{Offset: 4}}},
+		{name: "currency", text: "$(12.35)", want: nil},
+		{name: "number", text: "12,345.67%", want: nil},
+		{name: "combining mark after space", text: "a \u0301b", want: []Break{{Offset: 2}}},
+		{name: "ideographs", text: "中文字符", want: []Break{{Offset: 3}, {Offset: 6}, {Offset: 9}}},
+		{name: "ideographic full stop", text: "中。文", want: []Break{{Offset: 6}}},
+		{name: "halfwidth katakana", text: "ｶﾀｶﾅ", want: []Break{{Offset: 3}, {Offset: 6}, {Offset: 9}}},
+		{name: "hangul", text: "한국 어", want: []Break{{Offset: 3}, {Offset: 7}}},
+		{name: "emoji modifier", text: "👍🏽 x", want: []Break{{Offset: 9}}},
+		{name: "regional indicators", text: "🇫🇷🇩🇪", want: []Break{{Offset: 8}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LineBreaks(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LineBreaks(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
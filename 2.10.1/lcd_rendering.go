@@ -0,0 +1,170 @@
+package freetype2
+
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include FT_LCD_FILTER_H
+// #include FT_CONFIG_OPTIONS_H
+//
+// #ifdef FT_CONFIG_OPTION_SUBPIXEL_RENDERING
+// #define GO_FT_SUBPIXEL_RENDERING 1
+// #else
+// #define GO_FT_SUBPIXEL_RENDERING 0
+// #endif
+import "C"
+
+import (
+	"errors"
+	"image"
+	"unsafe"
+)
+
+// ErrUnsupportedPixelMode occurs when a bitmap does not have the pixel mode an
+// operation expects.
+var ErrUnsupportedPixelMode = errors.New("unsupported pixel mode")
+
+// LCDFilter is a list of values to identify various types of LCD filters.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-lcd_rendering.html#ft_lcdfilter
+type LCDFilter int
+
+const (
+	// LCDFilterNone does not perform filtering. When used with subpixel
+	// rendering, this results in sometimes severe color fringes.
+	LCDFilterNone LCDFilter = C.FT_LCD_FILTER_NONE
+	// LCDFilterDefault is a beveled, normalized, and color-balanced five-tap
+	// filter with weights of [0x08 0x4D 0x56 0x4D 0x08] in 1/256th units.
+	LCDFilterDefault LCDFilter = C.FT_LCD_FILTER_DEFAULT
+	// LCDFilterLight is a boxy, normalized, and color-balanced three-tap
+	// filter with weights of [0x00 0x55 0x56 0x55 0x00] in 1/256th units.
+	LCDFilterLight LCDFilter = C.FT_LCD_FILTER_LIGHT
+	// LCDFilterLegacy1 is an alias for LCDFilterLegacy.
+	LCDFilterLegacy1 LCDFilter = C.FT_LCD_FILTER_LEGACY1
+	// LCDFilterLegacy corresponds to a 3-tap filter used by the former
+	// ClearType-like rendering, it is neither normalized nor color-balanced.
+	// It is kept for compatibility and should not be used otherwise.
+	LCDFilterLegacy LCDFilter = C.FT_LCD_FILTER_LEGACY
+)
+
+func (f LCDFilter) String() string {
+	switch f {
+	case LCDFilterNone:
+		return "None"
+	case LCDFilterDefault:
+		return "Default"
+	case LCDFilterLight:
+		return "Light"
+	case LCDFilterLegacy1:
+		return "Legacy1"
+	case LCDFilterLegacy:
+		return "Legacy"
+	default:
+		return "Unknown"
+	}
+}
+
+// LCDFilterWeights holds the weights of a five-tap LCD filter, in 1/256th
+// units. For a normalized filter the weights add up to 0x100.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-lcd_rendering.html#ft_lcdfivetapfilter
+type LCDFilterWeights [C.FT_LCD_FILTER_FIVE_TAPS]byte
+
+// SetLCDFilter applies color filtering to LCD decimated bitmaps, like the ones
+// used when rendering with RenderModeLCD or RenderModeLCDV.
+//
+// Since subpixel rendering is not enabled in the bundled static libraries, it
+// returns ErrUnimplementedFeature when using them, see SetLCDGeometry instead.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-lcd_rendering.html#ft_library_setlcdfilter
+func (l *Library) SetLCDFilter(filter LCDFilter) error {
+	if l == nil || l.ptr == nil {
+		return ErrInvalidLibraryHandle
+	}
+
+	return getErr(C.FT_Library_SetLcdFilter(l.ptr, C.FT_LcdFilter(filter)))
+}
+
+// SetLCDFilterWeights enables the usage of a custom LCD filter. This overrides
+// the filter selected with SetLCDFilter.
+//
+// Since subpixel rendering is not enabled in the bundled static libraries, it
+// returns ErrUnimplementedFeature when using them.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-lcd_rendering.html#ft_library_setlcdfilterweights
+func (l *Library) SetLCDFilterWeights(weights LCDFilterWeights) error {
+	if l == nil || l.ptr == nil {
+		return ErrInvalidLibraryHandle
+	}
+
+	cweights := (*C.uchar)(C.CBytes(weights[:]))
+	defer free(unsafe.Pointer(cweights))
+
+	return getErr(C.FT_Library_SetLcdFilterWeights(l.ptr, cweights))
+}
+
+// SetLCDGeometry sets the positions of the red, green and blue subpixels in
+// 1/64th of a pixel, when FreeType uses its own LCD rendering technology
+// (Harmony) instead of filtering, which is the case of the bundled static
+// libraries.
+//
+// The default geometry is a horizontal RGB stripe layout, that is
+// {-21, 0}, {0, 0}, {21, 0}. A vertical layout should be set with a 90°
+// rotation, that is {0, -21}, {0, 0}, {0, 21} for RenderModeLCDV.
+//
+// It returns ErrUnimplementedFeature if FreeType was built with subpixel
+// rendering enabled.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-lcd_rendering.html#ft_library_setlcdgeometry
+func (l *Library) SetLCDGeometry(sub [3]Vector) error {
+	if l == nil || l.ptr == nil {
+		return ErrInvalidLibraryHandle
+	}
+
+	var csub [3]C.FT_Vector
+	for i, v := range sub {
+		csub[i] = C.FT_Vector{x: C.FT_Pos(v.X), y: C.FT_Pos(v.Y)}
+	}
+
+	err := getErr(C.FT_Library_SetLcdGeometry(l.ptr, &csub[0]))
+	if err == ErrUnimplementedFeature && C.GO_FT_SUBPIXEL_RENDERING == 0 {
+		// FreeType 2.10.1 stores the geometry but still reports the feature as
+		// unimplemented when using Harmony.
+		return nil
+	}
+	return err
+}
+
+// SetLCDFilterWeights overrides the library's LCD filter for this face. A nil
+// value resets the face to the library's filter. It is a shorthand for
+// SetProperties.
+//
+// Since subpixel rendering is not enabled in the bundled static libraries, it
+// returns ErrUnimplementedFeature when using them.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_face_properties
+func (f *Face) SetLCDFilterWeights(weights *LCDFilterWeights) error {
+	if weights == nil {
+		return f.SetProperties(FaceProperties{Reset: FacePropertyLCDFilterWeights})
+	}
+	return f.SetProperties(FaceProperties{LCDFilterWeights: weights})
+}
+
+// LCDCoverage converts a bitmap in PixelModeLCD or PixelModeLCDV, where each
+// pixel is made of three subpixel samples, into an RGB coverage mask.
+//
+// The R, G and B components of each pixel hold the coverage of the matching
+// subpixel, A holds the maximum of the three so that the result is a valid
+// premultiplied image. Subpixel compositing blends each channel separately,
+// that is dst.R = src.R*mask.R + dst.R*(1-mask.R) and likewise for G and B.
+//
+// It returns ErrUnsupportedPixelMode if b is not an LCD bitmap.
+func (b *Bitmap) LCDCoverage() (*image.RGBA, error) {
+	if b == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	if b.PixelMode != PixelModeLCD && b.PixelMode != PixelModeLCDV {
+		return nil, ErrUnsupportedPixelMode
+	}
+
+	return b.RGBA()
+}
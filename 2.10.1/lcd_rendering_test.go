@@ -0,0 +1,238 @@
+package freetype2
+
+import (
+	"image"
+	"testing"
+)
+
+func TestLCDFilter_String(t *testing.T) {
+	tests := []struct {
+		name string
+		f    LCDFilter
+		want string
+	}{
+		{name: "None", f: LCDFilterNone, want: "None"},
+		{name: "Default", f: LCDFilterDefault, want: "Default"},
+		{name: "Light", f: LCDFilterLight, want: "Light"},
+		{name: "Legacy1", f: LCDFilterLegacy1, want: "Legacy1"},
+		{name: "Legacy", f: LCDFilterLegacy, want: "Legacy"},
+		{name: "Unknown", f: 901929, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.String(); got != tt.want {
+				t.Errorf("LCDFilter.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLibrary_SetLCDFilter(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+	defer l.Free()
+
+	tests := []struct {
+		name    string
+		lib     *Library
+		filter  LCDFilter
+		wantErr error
+	}{
+		{name: "nil lib", lib: nil, filter: LCDFilterDefault, wantErr: ErrInvalidLibraryHandle},
+		// the bundled libs use Harmony instead of subpixel filtering
+		{name: "default", lib: l, filter: LCDFilterDefault, wantErr: ErrUnimplementedFeature},
+		{name: "none", lib: l, filter: LCDFilterNone, wantErr: ErrUnimplementedFeature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.lib.SetLCDFilter(tt.filter); err != tt.wantErr {
+				t.Errorf("Library.SetLCDFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLibrary_SetLCDFilterWeights(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+	defer l.Free()
+
+	tests := []struct {
+		name    string
+		lib     *Library
+		weights LCDFilterWeights
+		wantErr error
+	}{
+		{name: "nil lib", lib: nil, weights: LCDFilterWeights{0x08, 0x4D, 0x56, 0x4D, 0x08}, wantErr: ErrInvalidLibraryHandle},
+		// the bundled libs use Harmony instead of subpixel filtering
+		{name: "custom", lib: l, weights: LCDFilterWeights{0x08, 0x4D, 0x56, 0x4D, 0x08}, wantErr: ErrUnimplementedFeature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.lib.SetLCDFilterWeights(tt.weights); err != tt.wantErr {
+				t.Errorf("Library.SetLCDFilterWeights() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLibrary_SetLCDGeometry(t *testing.T) {
+	var nilLib *Library
+	if err := nilLib.SetLCDGeometry([3]Vector{}); err != ErrInvalidLibraryHandle {
+		t.Errorf("Library.SetLCDGeometry() error = %v, wantErr %v", err, ErrInvalidLibraryHandle)
+	}
+
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(10<<6, 10<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set char size: %v", err)
+	}
+
+	render := func() []byte {
+		if err := face.LoadChar('l', LoadRender|LoadTargetLCD); err != nil {
+			t.Fatalf("unable to load char: %v", err)
+		}
+		return face.GlyphSlot().Bitmap.Buffer[:9]
+	}
+
+	if diff := diff(render(), []byte{0, 64, 148, 236, 184, 100, 12, 0, 0}); diff != nil {
+		t.Errorf("RGB geometry = %v", diff)
+	}
+
+	if err := face.l.SetLCDGeometry([3]Vector{{X: 21}, {}, {X: -21}}); err != nil {
+		t.Fatalf("Library.SetLCDGeometry() error = %v", err)
+	}
+
+	if diff := diff(render(), []byte{148, 64, 0, 100, 184, 236, 0, 0, 12}); diff != nil {
+		t.Errorf("BGR geometry = %v", diff)
+	}
+}
+
+func TestFace_SetLCDFilterWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		weights *LCDFilterWeights
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, weights: nil, wantErr: ErrInvalidFaceHandle},
+		// the bundled libs use Harmony instead of subpixel filtering
+		{name: "custom", face: goRegular, weights: &LCDFilterWeights{0x00, 0x55, 0x56, 0x55, 0x00}, wantErr: ErrUnimplementedFeature},
+		{name: "reset", face: goRegular, weights: nil, wantErr: ErrUnimplementedFeature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			if err := face.SetLCDFilterWeights(tt.weights); err != tt.wantErr {
+				t.Errorf("Face.SetLCDFilterWeights() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBitmap_LCDCoverage(t *testing.T) {
+	tests := []struct {
+		name    string
+		b       *Bitmap
+		want    *image.RGBA
+		wantErr error
+	}{
+		{name: "nil", b: nil, want: nil, wantErr: ErrInvalidArgument},
+		{name: "gray", b: &Bitmap{Rows: 1, Width: 3, Pitch: 3, Buffer: []byte{1, 2, 3}, PixelMode: PixelModeGray}, want: nil, wantErr: ErrUnsupportedPixelMode},
+		{name: "empty", b: &Bitmap{PixelMode: PixelModeLCD}, want: &image.RGBA{Pix: []byte{}, Rect: image.Rect(0, 0, 0, 0)}, wantErr: nil},
+		{
+			name: "lcd",
+			b: &Bitmap{
+				Rows:  2,
+				Width: 6,
+				Pitch: 8,
+				Buffer: []byte{
+					1, 2, 3, 4, 5, 6, 0, 0,
+					7, 8, 9, 12, 11, 10, 0, 0,
+				},
+				PixelMode: PixelModeLCD,
+			},
+			want: &image.RGBA{
+				Pix: []byte{
+					1, 2, 3, 3, 4, 5, 6, 6,
+					7, 8, 9, 9, 12, 11, 10, 12,
+				},
+				Stride: 8,
+				Rect:   image.Rect(0, 0, 2, 2),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "lcd up flow",
+			b: &Bitmap{
+				Rows:  2,
+				Width: 6,
+				Pitch: -8,
+				Buffer: []byte{
+					7, 8, 9, 12, 11, 10, 0, 0,
+					1, 2, 3, 4, 5, 6, 0, 0,
+				},
+				PixelMode: PixelModeLCD,
+			},
+			want: &image.RGBA{
+				Pix: []byte{
+					1, 2, 3, 3, 4, 5, 6, 6,
+					7, 8, 9, 9, 12, 11, 10, 12,
+				},
+				Stride: 8,
+				Rect:   image.Rect(0, 0, 2, 2),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "lcdv",
+			b: &Bitmap{
+				Rows:  6,
+				Width: 2,
+				Pitch: 4,
+				Buffer: []byte{
+					1, 4, 0, 0,
+					2, 5, 0, 0,
+					3, 6, 0, 0,
+					7, 12, 0, 0,
+					8, 11, 0, 0,
+					9, 10, 0, 0,
+				},
+				PixelMode: PixelModeLCDV,
+			},
+			want: &image.RGBA{
+				Pix: []byte{
+					1, 2, 3, 3, 4, 5, 6, 6,
+					7, 8, 9, 9, 12, 11, 10, 12,
+				},
+				Stride: 8,
+				Rect:   image.Rect(0, 0, 2, 2),
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.b.LCDCoverage()
+			if err != tt.wantErr {
+				t.Errorf("Bitmap.LCDCoverage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Bitmap.LCDCoverage() = %v", diff)
+			}
+		})
+	}
+}
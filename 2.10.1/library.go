@@ -91,7 +91,8 @@ func (l *Library) Version() Version {
 }
 
 // NewFace creates a new face from the given io.Reader.
-// Beware, the data will be read, all at once, into memory.
+// Beware, the data will be read, all at once, into memory. Use OpenFace to have
+// FreeType read it on demand instead.
 //
 // See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_new_memory_face
 func (l *Library) NewFace(r io.Reader, index, namedInstanceIndex int) (*Face, error) {
@@ -129,6 +130,53 @@ func (l *Library) NewFace(r io.Reader, index, namedInstanceIndex int) (*Face, er
 	return f, nil
 }
 
+// OpenFace creates a new face backed by the given io.ReaderAt, size being the
+// number of bytes available in r.
+//
+// Unlike NewFace, the data is not copied into memory: FreeType reads the bytes
+// it needs on demand, for as long as the face is alive. r must therefore remain
+// valid until the face (or the library) is freed, and must not be used
+// concurrently with the face unless it is safe to do so. OpenFace does not
+// close r.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_open_face
+func (l *Library) OpenFace(r io.ReaderAt, size int64, index, namedInstanceIndex int) (*Face, error) {
+	if l == nil || l.ptr == nil {
+		return nil, ErrInvalidLibraryHandle
+	}
+
+	if r == nil {
+		return nil, ErrInvalidStreamHandle
+	}
+
+	if size <= 0 {
+		return nil, ErrUnknownFileFormat
+	}
+
+	// FreeType closes the stream, releasing it, when the face is discarded or
+	// when opening it fails.
+	stream := faceStreams.acquire(r, size)
+	args := C.FT_Open_Args{
+		flags:  C.FT_OPEN_STREAM,
+		stream: stream,
+	}
+
+	var face C.FT_Face
+	if err := getErr(C.FT_Open_Face(
+		l.ptr,
+		&args,
+		C.FT_Long(index&0xFFFF|namedInstanceIndex<<16),
+		&face,
+	)); err != nil {
+		return nil, err
+	}
+
+	f := &Face{ptr: face, lib: l}
+	f.init()
+	l.faces = append(l.faces, f)
+	return f, nil
+}
+
 // NewFaceFromPath creates a new face from the given path.
 //
 // See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_new_face
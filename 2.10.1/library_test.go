@@ -1,7 +1,11 @@
 package freetype2
 
 import (
+	"bytes"
+	"io"
+	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
 )
 
@@ -146,6 +150,18 @@ func TestNewFace(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("OpenFace()", func(t *testing.T) {
+		for _, tc := range tests {
+			test(t, tc, func(l *Library, path string) (*Face, error) {
+				data, err := ioutil.ReadFile(path)
+				if err != nil {
+					return nil, err
+				}
+				return l.OpenFace(bytes.NewReader(data), int64(len(data)), 0, 0)
+			})
+		}
+	})
 }
 
 func TestNewFaceOnNilLib(t *testing.T) {
@@ -231,3 +247,139 @@ func TestNewFaceFromPathWithEmptyFile(t *testing.T) {
 		t.Errorf("want err: %v, got %v", want, err)
 	}
 }
+
+func TestOpenFaceOnNilLib(t *testing.T) {
+	var l *Library
+	want := ErrInvalidLibraryHandle
+	if _, err := l.OpenFace(nil, 0, 0, 0); err != want {
+		t.Errorf("want err: %v, got %v", want, err)
+	}
+}
+
+func TestOpenFaceWithNilReader(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+	defer l.Free()
+
+	want := ErrInvalidStreamHandle
+	if _, err := l.OpenFace(nil, 10, 0, 0); err != want {
+		t.Errorf("want err: %v, got %v", want, err)
+	}
+}
+
+func TestOpenFaceWithNoData(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+	defer l.Free()
+
+	want := ErrUnknownFileFormat
+	if _, err := l.OpenFace(bytes.NewReader(nil), 0, 0, 0); err != want {
+		t.Errorf("want err: %v, got %v", want, err)
+	}
+}
+
+type countingReaderAt struct {
+	mu sync.Mutex
+	r  io.ReaderAt
+	n  int
+}
+
+func (c *countingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n, err := c.r.ReadAt(p, off)
+	c.mu.Lock()
+	c.n += n
+	c.mu.Unlock()
+	return n, err
+}
+
+func (c *countingReaderAt) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.n
+}
+
+func TestOpenFaceReadsOnDemand(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+	defer l.Free()
+
+	data, err := ioutil.ReadFile(testdata("go", "Go-Regular.ttf"))
+	if err != nil {
+		t.Fatalf("unable to read file: %s", err)
+	}
+
+	r := &countingReaderAt{r: bytes.NewReader(data)}
+	f, err := l.OpenFace(r, int64(len(data)), 0, 0)
+	if err != nil {
+		t.Fatalf("unable to open face: %s", err)
+	}
+	defer f.Free()
+
+	opened := r.count()
+	if opened == 0 || opened >= len(data) {
+		t.Errorf("expected a partial read of %d bytes, got %d", len(data), opened)
+	}
+
+	if err := f.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set char size: %s", err)
+	}
+	if err := f.LoadChar('A', LoadRender); err != nil {
+		t.Fatalf("unable to load char: %s", err)
+	}
+	if got := r.count(); got <= opened {
+		t.Errorf("expected loading a glyph to read from the stream, read %d bytes before and %d after", opened, got)
+	}
+	if bmp := f.GlyphSlot().Bitmap; bmp.Width == 0 || bmp.Rows == 0 {
+		t.Errorf("expected a non empty bitmap, got %dx%d", bmp.Width, bmp.Rows)
+	}
+}
+
+func TestOpenFaceReleasesStream(t *testing.T) {
+	open := func(t *testing.T, l *Library, path string) (*Face, error) {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("unable to read file: %s", err)
+		}
+		return l.OpenFace(bytes.NewReader(data), int64(len(data)), 0, 0)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		free    func(*Library, *Face)
+		wantErr error
+	}{
+		{name: "Face.Free", path: testdata("go", "Go-Regular.ttf"), free: func(_ *Library, f *Face) { f.Free() }},
+		{name: "Library.Free", path: testdata("go", "Go-Regular.ttf"), free: func(l *Library, _ *Face) { l.Free() }},
+		{name: "woff", path: testdata("chromacheck", "chromacheck-colr.woff"), free: func(_ *Library, f *Face) { f.Free() }},
+		{name: "error", path: testdata("gohu", "LICENSE"), wantErr: ErrUnknownFileFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLibrary()
+			if err != nil {
+				t.Fatalf("unable to init lib: %s", err)
+			}
+			defer l.Free()
+
+			before := faceStreams.len()
+			f, err := open(t, l, tt.path)
+			if err != tt.wantErr {
+				t.Fatalf("want err: %v, got %v", tt.wantErr, err)
+			}
+			if tt.free != nil {
+				tt.free(l, f)
+			}
+
+			if got := faceStreams.len(); got != before {
+				t.Errorf("stream was not released, want %d live streams, got %d", before, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package freetype2
+
+// OutlineBuilder builds an Outline from path commands, taking care of the
+// point tags and contour ends.
+//
+// Coordinates are in 26.6 fixed-point units, with the y axis pointing upwards
+// like glyph outlines. Contours are always closed when rendered, so calling
+// Close is optional; it moves the pen back to the start of the contour.
+//
+// The zero value is an empty builder ready to use.
+type OutlineBuilder struct {
+	points   []Vector
+	tags     []byte
+	contours []int16
+	flags    OutlineFlag
+
+	pen   Vector
+	start int // the first point of the open contour
+	open  bool
+}
+
+// MoveTo starts a new contour at to, ending the current one.
+func (b *OutlineBuilder) MoveTo(to Vector) {
+	b.end()
+
+	b.start = len(b.points)
+	b.open = true
+	b.add(to, CurveTagOn)
+}
+
+// LineTo adds a line segment from the pen to to. A new contour is started at
+// the pen if there is none.
+func (b *OutlineBuilder) LineTo(to Vector) {
+	b.begin()
+	b.add(to, CurveTagOn)
+}
+
+// QuadTo adds a second-order Bezier arc from the pen to to. A new contour is
+// started at the pen if there is none.
+func (b *OutlineBuilder) QuadTo(control, to Vector) {
+	b.begin()
+	b.add(control, CurveTagConic)
+	b.add(to, CurveTagOn)
+}
+
+// CubicTo adds a third-order Bezier arc from the pen to to. A new contour is
+// started at the pen if there is none.
+func (b *OutlineBuilder) CubicTo(control1, control2, to Vector) {
+	b.begin()
+	b.add(control1, CurveTagCubic)
+	b.add(control2, CurveTagCubic)
+	b.add(to, CurveTagOn)
+}
+
+// Close ends the current contour, moving the pen back to its first point.
+func (b *OutlineBuilder) Close() {
+	if !b.open {
+		return
+	}
+
+	start := b.points[b.start]
+	b.end()
+	b.pen = start
+}
+
+// SetFlags sets the flags of the built outline, like OutlineEvenOddFill.
+func (b *OutlineBuilder) SetFlags(flags OutlineFlag) {
+	b.flags = flags
+}
+
+// Reset discards everything added to the builder.
+func (b *OutlineBuilder) Reset() {
+	*b = OutlineBuilder{}
+}
+
+// Outline creates a new outline from the contours added so far. The builder is
+// left untouched and can keep being used.
+//
+// It returns ErrArrayTooLarge if there are more than 0xFFFF (65535) points.
+//
+// The outline is freed by Outline.Free, or along with the library by
+// Library.Free.
+func (b *OutlineBuilder) Outline(l *Library) (*Outline, error) {
+	if l == nil || l.ptr == nil {
+		return nil, ErrInvalidLibraryHandle
+	}
+
+	points, tags, contours := b.points, b.tags, b.contours
+	if b.open {
+		// close the open contour without modifying the builder.
+		points, tags = b.trim(points, tags)
+		if len(points) > b.start {
+			contours = append(contours[:len(contours):len(contours)], int16(len(points)-1))
+		}
+	}
+
+	if len(points) > 0xffff {
+		return nil, ErrArrayTooLarge
+	}
+
+	ret, err := l.NewOutline(len(points), len(contours))
+	if err != nil {
+		return nil, err
+	}
+
+	ret.Points = append([]Vector(nil), points...)
+	ret.Tags = append([]byte(nil), tags...)
+	ret.Contours = append([]int16(nil), contours...)
+	ret.Flags = b.flags
+	ret.store()
+	ret.reload()
+
+	return ret, nil
+}
+
+func (b *OutlineBuilder) begin() {
+	if !b.open {
+		b.MoveTo(b.pen)
+	}
+}
+
+func (b *OutlineBuilder) end() {
+	if !b.open {
+		return
+	}
+
+	b.open = false
+	b.points, b.tags = b.trim(b.points, b.tags)
+	if len(b.points) > b.start {
+		b.contours = append(b.contours, int16(len(b.points)-1))
+	}
+}
+
+// trim drops a lone MoveTo and the last point of the open contour if it
+// duplicates the first one, as contours are implicitly closed.
+func (b *OutlineBuilder) trim(points []Vector, tags []byte) ([]Vector, []byte) {
+	n := len(points) - b.start
+	if n == 1 || (n > 2 && points[len(points)-1] == points[b.start] && tags[len(tags)-1] == CurveTagOn) {
+		points, tags = points[:len(points)-1], tags[:len(tags)-1]
+	}
+	return points, tags
+}
+
+func (b *OutlineBuilder) add(v Vector, tag byte) {
+	b.points = append(b.points, v)
+	b.tags = append(b.tags, tag)
+	b.pen = v
+}
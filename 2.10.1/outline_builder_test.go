@@ -0,0 +1,227 @@
+package freetype2
+
+import (
+	"image"
+	"image/draw"
+	"testing"
+)
+
+func TestOutlineBuilder_Outline(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to create lib: %v", err)
+	}
+	defer l.Free()
+
+	var nilBuilder OutlineBuilder
+	if _, err := nilBuilder.Outline(nil); err != ErrInvalidLibraryHandle {
+		t.Errorf("OutlineBuilder.Outline() error = %v, want %v", err, ErrInvalidLibraryHandle)
+	}
+
+	tests := []struct {
+		name  string
+		build func(b *OutlineBuilder)
+		want  *Outline
+	}{
+		{
+			name:  "empty",
+			build: func(b *OutlineBuilder) {},
+			want:  &Outline{userCreated: true, Flags: OutlineOwner},
+		},
+		{
+			name: "lone move",
+			build: func(b *OutlineBuilder) {
+				b.MoveTo(Vector{X: 64, Y: 64})
+				b.MoveTo(Vector{X: 128, Y: 128})
+			},
+			want: &Outline{userCreated: true, Flags: OutlineOwner},
+		},
+		{
+			name: "triangle",
+			build: func(b *OutlineBuilder) {
+				b.MoveTo(Vector{X: 0, Y: 0})
+				b.LineTo(Vector{X: 64, Y: 0})
+				b.LineTo(Vector{X: 0, Y: 64})
+				b.Close()
+			},
+			want: &Outline{
+				userCreated: true,
+				Points:      []Vector{{X: 0, Y: 0}, {X: 64, Y: 0}, {X: 0, Y: 64}},
+				Tags:        []byte{CurveTagOn, CurveTagOn, CurveTagOn},
+				Contours:    []int16{2},
+				Flags:       OutlineOwner,
+			},
+		},
+		{
+			name: "explicitly closed",
+			build: func(b *OutlineBuilder) {
+				b.MoveTo(Vector{X: 0, Y: 0})
+				b.LineTo(Vector{X: 64, Y: 0})
+				b.LineTo(Vector{X: 0, Y: 64})
+				b.LineTo(Vector{X: 0, Y: 0})
+			},
+			want: &Outline{
+				userCreated: true,
+				Points:      []Vector{{X: 0, Y: 0}, {X: 64, Y: 0}, {X: 0, Y: 64}},
+				Tags:        []byte{CurveTagOn, CurveTagOn, CurveTagOn},
+				Contours:    []int16{2},
+				Flags:       OutlineOwner,
+			},
+		},
+		{
+			name: "curves",
+			build: func(b *OutlineBuilder) {
+				b.MoveTo(Vector{X: 0, Y: 0})
+				b.QuadTo(Vector{X: 32, Y: 64}, Vector{X: 64, Y: 0})
+				b.CubicTo(Vector{X: 64, Y: -32}, Vector{X: 0, Y: -32}, Vector{X: 0, Y: 0})
+			},
+			want: &Outline{
+				userCreated: true,
+				Points: []Vector{
+					{X: 0, Y: 0},
+					{X: 32, Y: 64}, {X: 64, Y: 0},
+					{X: 64, Y: -32}, {X: 0, Y: -32},
+				},
+				Tags:     []byte{CurveTagOn, CurveTagConic, CurveTagOn, CurveTagCubic, CurveTagCubic},
+				Contours: []int16{4},
+				Flags:    OutlineOwner,
+			},
+		},
+		{
+			name: "implicit move",
+			build: func(b *OutlineBuilder) {
+				b.LineTo(Vector{X: 64, Y: 0})
+				b.LineTo(Vector{X: 64, Y: 64})
+				b.Close()
+				// the pen is back at the origin.
+				b.LineTo(Vector{X: -64, Y: 0})
+				b.LineTo(Vector{X: -64, Y: -64})
+			},
+			want: &Outline{
+				userCreated: true,
+				Points: []Vector{
+					{X: 0, Y: 0}, {X: 64, Y: 0}, {X: 64, Y: 64},
+					{X: 0, Y: 0}, {X: -64, Y: 0}, {X: -64, Y: -64},
+				},
+				Tags:     []byte{CurveTagOn, CurveTagOn, CurveTagOn, CurveTagOn, CurveTagOn, CurveTagOn},
+				Contours: []int16{2, 5},
+				Flags:    OutlineOwner,
+			},
+		},
+		{
+			name: "flags",
+			build: func(b *OutlineBuilder) {
+				b.SetFlags(OutlineEvenOddFill)
+				b.MoveTo(Vector{X: 0, Y: 0})
+				b.LineTo(Vector{X: 64, Y: 0})
+				b.LineTo(Vector{X: 0, Y: 64})
+			},
+			want: &Outline{
+				userCreated: true,
+				Points:      []Vector{{X: 0, Y: 0}, {X: 64, Y: 0}, {X: 0, Y: 64}},
+				Tags:        []byte{CurveTagOn, CurveTagOn, CurveTagOn},
+				Contours:    []int16{2},
+				Flags:       OutlineOwner | OutlineEvenOddFill,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b OutlineBuilder
+			tt.build(&b)
+
+			got, err := b.Outline(l)
+			if err != nil {
+				t.Fatalf("OutlineBuilder.Outline() error = %v", err)
+			}
+			defer got.Free()
+
+			if !got.Check() {
+				t.Errorf("OutlineBuilder.Outline() returned an invalid outline")
+			}
+
+			// the C outline must hold the same data.
+			got.reload()
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("OutlineBuilder.Outline() = %v", diff)
+			}
+
+			// building again must not be affected by the implicit close.
+			again, err := b.Outline(l)
+			if err != nil {
+				t.Fatalf("OutlineBuilder.Outline() error = %v", err)
+			}
+			defer again.Free()
+			if diff := diff(again, tt.want); diff != nil {
+				t.Errorf("OutlineBuilder.Outline() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestOutlineBuilder_Reset(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to create lib: %v", err)
+	}
+	defer l.Free()
+
+	var b OutlineBuilder
+	b.SetFlags(OutlineEvenOddFill)
+	b.MoveTo(Vector{X: 0, Y: 0})
+	b.LineTo(Vector{X: 64, Y: 0})
+	b.Reset()
+
+	got, err := b.Outline(l)
+	if err != nil {
+		t.Fatalf("OutlineBuilder.Outline() error = %v", err)
+	}
+	if diff := diff(got, &Outline{userCreated: true, Flags: OutlineOwner}); diff != nil {
+		t.Errorf("OutlineBuilder.Reset() = %v", diff)
+	}
+}
+
+func TestOutlineBuilder_render(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to create lib: %v", err)
+	}
+	defer l.Free()
+
+	// a 4x4 square with a 2x2 hole, using the non-zero winding rule.
+	var b OutlineBuilder
+	b.MoveTo(Vector{X: 0, Y: 0})
+	b.LineTo(Vector{X: 0, Y: 4 << 6})
+	b.LineTo(Vector{X: 4 << 6, Y: 4 << 6})
+	b.LineTo(Vector{X: 4 << 6, Y: 0})
+	b.Close()
+	b.MoveTo(Vector{X: 1 << 6, Y: 1 << 6})
+	b.LineTo(Vector{X: 3 << 6, Y: 1 << 6})
+	b.LineTo(Vector{X: 3 << 6, Y: 3 << 6})
+	b.LineTo(Vector{X: 1 << 6, Y: 3 << 6})
+	b.Close()
+
+	o, err := b.Outline(l)
+	if err != nil {
+		t.Fatalf("OutlineBuilder.Outline() error = %v", err)
+	}
+
+	if got, want := o.CBox(), (BBox{XMax: 4 << 6, YMax: 4 << 6}); got != want {
+		t.Errorf("Outline.CBox() = %v, want %v", got, want)
+	}
+
+	dst := image.NewAlpha(image.Rect(0, 0, 4, 4))
+	if err := o.Draw(l, dst, image.Pt(0, 4), image.Opaque, draw.Over); err != nil {
+		t.Fatalf("Outline.Draw() error = %v", err)
+	}
+
+	want := []byte{
+		0xff, 0xff, 0xff, 0xff,
+		0xff, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0xff,
+		0xff, 0xff, 0xff, 0xff,
+	}
+	if diff := diff(dst.Pix, want); diff != nil {
+		t.Errorf("Outline.Draw() = %v", diff)
+	}
+}
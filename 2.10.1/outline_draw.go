@@ -0,0 +1,143 @@
+package freetype2
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Draw renders the outline straight into dst, using the anti-aliased coverage
+// of each pixel as a mask to composite src with op, like draw.DrawMask.
+//
+// origin is the position in dst of the outline's origin, in integer pixels.
+// As the outline's y axis points upwards, a point at (x, y) in the outline is
+// drawn at (origin.X + x, origin.Y - y) in dst.
+//
+// src is aligned with dst, i.e., the pixel at (x, y) in dst is composited with
+// the color at (x, y) in src. This is usually an *image.Uniform.
+//
+// The output is clipped to dst's bounds, and pixels not covered by the outline
+// are left untouched, even when op is draw.Src. No intermediate bitmap is
+// created, so this is suitable for drawing into large images. Compositing is
+// done in place for *image.RGBA and *image.NRGBA; other images go through
+// their At and Set methods.
+//
+// It returns ErrInvalidArgument if dst or src are nil or if op is unknown.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-outline_processing.html#ft_outline_render
+func (o *Outline) Draw(l *Library, dst draw.Image, origin image.Point, src image.Image, op draw.Op) error {
+	if o == nil || o.ptr == nil {
+		return ErrInvalidOutline
+	}
+
+	if l == nil || l.ptr == nil {
+		return ErrInvalidLibraryHandle
+	}
+
+	if dst == nil || src == nil || (op != draw.Over && op != draw.Src) {
+		return ErrInvalidArgument
+	}
+
+	r := dst.Bounds()
+	if r.Empty() {
+		return nil
+	}
+
+	// like draw.DrawMask, the color of uniform sources is computed once instead
+	// of for each pixel.
+	var ur, ug, ub, ua uint32
+	u, uniform := src.(*image.Uniform)
+	if uniform {
+		ur, ug, ub, ua = u.C.RGBA()
+	}
+	srcAt := func(x, y int) (uint32, uint32, uint32, uint32) {
+		if uniform {
+			return ur, ug, ub, ua
+		}
+		return src.At(x, y).RGBA()
+	}
+
+	var span func(x0, x1, y int, ma uint32)
+	switch dst := dst.(type) {
+	case *image.RGBA:
+		span = func(x0, x1, y int, ma uint32) {
+			i := dst.PixOffset(x0, y)
+			for x := x0; x < x1; x, i = x+1, i+4 {
+				pix := dst.Pix[i : i+4 : i+4]
+				sr, sg, sb, sa := srcAt(x, y)
+				dr, dg, db, da := uint32(pix[0])*0x101, uint32(pix[1])*0x101, uint32(pix[2])*0x101, uint32(pix[3])*0x101
+				dr, dg, db, da = composite(dr, dg, db, da, sr, sg, sb, sa, ma, op)
+				pix[0], pix[1], pix[2], pix[3] = uint8(dr>>8), uint8(dg>>8), uint8(db>>8), uint8(da>>8)
+			}
+		}
+	case *image.NRGBA:
+		span = func(x0, x1, y int, ma uint32) {
+			i := dst.PixOffset(x0, y)
+			for x := x0; x < x1; x, i = x+1, i+4 {
+				pix := dst.Pix[i : i+4 : i+4]
+				sr, sg, sb, sa := srcAt(x, y)
+				dr, dg, db, da := color.NRGBA{R: pix[0], G: pix[1], B: pix[2], A: pix[3]}.RGBA()
+				dr, dg, db, da = composite(dr, dg, db, da, sr, sg, sb, sa, ma, op)
+				// un-premultiply like color.NRGBAModel.
+				if da != 0 && da != 0xffff {
+					dr, dg, db = dr*0xffff/da, dg*0xffff/da, db*0xffff/da
+				}
+				pix[0], pix[1], pix[2], pix[3] = uint8(dr>>8), uint8(dg>>8), uint8(db>>8), uint8(da>>8)
+			}
+		}
+	default:
+		span = func(x0, x1, y int, ma uint32) {
+			for x := x0; x < x1; x++ {
+				sr, sg, sb, sa := srcAt(x, y)
+				dr, dg, db, da := dst.At(x, y).RGBA()
+				dr, dg, db, da = composite(dr, dg, db, da, sr, sg, sb, sa, ma, op)
+				dst.Set(x, y, color.RGBA64{R: uint16(dr), G: uint16(dg), B: uint16(db), A: uint16(da)})
+			}
+		}
+	}
+
+	return o.Render(l, RasterParams{
+		Flags: RasterFlagAA | RasterFlagDirect | RasterFlagClip,
+		GraySpans: func(upwardY int, spans []Span) {
+			y := origin.Y - upwardY - 1
+			if y < r.Min.Y || y >= r.Max.Y {
+				return
+			}
+
+			for _, s := range spans {
+				x0 := origin.X + int(s.X)
+				x1 := x0 + int(s.Len)
+				if x0 < r.Min.X {
+					x0 = r.Min.X
+				}
+				if x1 > r.Max.X {
+					x1 = r.Max.X
+				}
+				if x0 >= x1 || s.Coverage == 0 {
+					continue
+				}
+
+				span(x0, x1, y, uint32(s.Coverage)*0x101)
+			}
+		},
+		ClipBox: BBox{
+			XMin: Pos(r.Min.X - origin.X),
+			YMin: Pos(origin.Y - r.Max.Y),
+			XMax: Pos(r.Max.X - origin.X),
+			YMax: Pos(origin.Y - r.Min.Y),
+		},
+	})
+}
+
+// composite applies op to a premultiplied destination and source color, with
+// the mask alpha ma, using the same arithmetic as draw.DrawMask.
+func composite(dr, dg, db, da, sr, sg, sb, sa, ma uint32, op draw.Op) (uint32, uint32, uint32, uint32) {
+	const m = 1<<16 - 1
+
+	if op == draw.Over {
+		a := m - (sa * ma / m)
+		return (dr*a + sr*ma) / m, (dg*a + sg*ma) / m, (db*a + sb*ma) / m, (da*a + sa*ma) / m
+	}
+
+	return sr * ma / m, sg * ma / m, sb * ma / m, sa * ma / m
+}
@@ -0,0 +1,156 @@
+package freetype2
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+func TestOutline_Draw_errors(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to create lib: %v", err)
+	}
+	defer l.Free()
+
+	o, err := l.NewOutline(10, 10)
+	if err != nil {
+		t.Fatalf("unable to create outline: %v", err)
+	}
+	defer o.Free()
+
+	dst := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	src := image.NewUniform(color.Black)
+
+	var nilOutline *Outline
+	if err := nilOutline.Draw(l, dst, image.Point{}, src, draw.Over); err != ErrInvalidOutline {
+		t.Errorf("Outline.Draw() error = %v, want %v", err, ErrInvalidOutline)
+	}
+	if err := o.Draw(nil, dst, image.Point{}, src, draw.Over); err != ErrInvalidLibraryHandle {
+		t.Errorf("Outline.Draw() error = %v, want %v", err, ErrInvalidLibraryHandle)
+	}
+	if err := o.Draw(l, nil, image.Point{}, src, draw.Over); err != ErrInvalidArgument {
+		t.Errorf("Outline.Draw() error = %v, want %v", err, ErrInvalidArgument)
+	}
+	if err := o.Draw(l, dst, image.Point{}, nil, draw.Over); err != ErrInvalidArgument {
+		t.Errorf("Outline.Draw() error = %v, want %v", err, ErrInvalidArgument)
+	}
+	if err := o.Draw(l, dst, image.Point{}, src, draw.Op(42)); err != ErrInvalidArgument {
+		t.Errorf("Outline.Draw() error = %v, want %v", err, ErrInvalidArgument)
+	}
+}
+
+func TestOutline_Draw(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set char size: %v", err)
+	}
+
+	// the rendered glyph is used as a mask for draw.DrawMask, which Draw must
+	// match pixel for pixel.
+	if err := face.LoadChar('A', LoadRender); err != nil {
+		t.Fatalf("unable to load char: %v", err)
+	}
+	slot := face.GlyphSlot()
+	mask := image.NewAlpha(slot.Bitmap.Bounds())
+	draw.Draw(mask, mask.Rect, slot.Bitmap, image.Point{}, draw.Src)
+	maskOffset := image.Pt(slot.BitmapLeft, -slot.BitmapTop)
+
+	if err := face.LoadChar('A', LoadDefault); err != nil {
+		t.Fatalf("unable to load char: %v", err)
+	}
+	outline := face.GlyphSlot().Outline
+
+	gradient := image.NewNRGBA(image.Rect(-20, -20, 40, 40))
+	for y := gradient.Rect.Min.Y; y < gradient.Rect.Max.Y; y++ {
+		for x := gradient.Rect.Min.X; x < gradient.Rect.Max.X; x++ {
+			gradient.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 4), G: uint8(y * 4), B: 0x80, A: uint8(0x80 + x*2)})
+		}
+	}
+	uniform := image.NewUniform(color.NRGBA{R: 0x20, G: 0x40, B: 0x80, A: 0xc0})
+
+	newImages := map[string]func(r image.Rectangle) draw.Image{
+		"rgba":   func(r image.Rectangle) draw.Image { return image.NewRGBA(r) },
+		"nrgba":  func(r image.Rectangle) draw.Image { return image.NewNRGBA(r) },
+		"rgba64": func(r image.Rectangle) draw.Image { return image.NewRGBA64(r) },
+	}
+
+	tests := []struct {
+		name   string
+		bounds image.Rectangle
+		origin image.Point
+		src    image.Image
+		op     draw.Op
+		fill   bool
+	}{
+		{name: "over", bounds: image.Rect(0, 0, 20, 20), origin: image.Pt(4, 15), src: uniform, op: draw.Over, fill: true},
+		{name: "over gradient", bounds: image.Rect(0, 0, 20, 20), origin: image.Pt(4, 15), src: gradient, op: draw.Over, fill: true},
+		{name: "src", bounds: image.Rect(0, 0, 20, 20), origin: image.Pt(4, 15), src: uniform, op: draw.Src},
+		{name: "clip top left", bounds: image.Rect(0, 0, 20, 20), origin: image.Pt(-3, 5), src: uniform, op: draw.Over, fill: true},
+		{name: "clip bottom right", bounds: image.Rect(0, 0, 8, 8), origin: image.Pt(4, 12), src: gradient, op: draw.Over, fill: true},
+		{name: "offset bounds", bounds: image.Rect(-10, -10, 5, 5), origin: image.Pt(-6, 3), src: uniform, op: draw.Over, fill: true},
+		{name: "outside", bounds: image.Rect(0, 0, 20, 20), origin: image.Pt(-50, 50), src: uniform, op: draw.Over, fill: true},
+	}
+	for _, tt := range tests {
+		for imgName, newImage := range newImages {
+			t.Run(tt.name+" "+imgName, func(t *testing.T) {
+				got, want := newImage(tt.bounds), newImage(tt.bounds)
+				if tt.fill {
+					draw.Draw(got, tt.bounds, gradient, tt.bounds.Min, draw.Src)
+					draw.Draw(want, tt.bounds, gradient, tt.bounds.Min, draw.Src)
+				}
+
+				if err := outline.Draw(face.l, got, tt.origin, tt.src, tt.op); err != nil {
+					t.Fatalf("Outline.Draw() error = %v", err)
+				}
+
+				min := tt.origin.Add(maskOffset)
+				r := image.Rectangle{Min: min, Max: min.Add(mask.Bounds().Size())}
+				draw.DrawMask(want, r, tt.src, r.Min, mask, image.Point{}, tt.op)
+
+				for y := tt.bounds.Min.Y; y < tt.bounds.Max.Y; y++ {
+					for x := tt.bounds.Min.X; x < tt.bounds.Max.X; x++ {
+						if got, want := got.At(x, y), want.At(x, y); got != want {
+							t.Errorf("Outline.Draw() (%d, %d) = %v, want %v", x, y, got, want)
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkOutline_Draw(b *testing.B) {
+	b.StopTimer()
+
+	face, err := goRegular()
+	if err != nil {
+		b.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(256<<6, 256<<6, 72, 72); err != nil {
+		b.Fatalf("unable to set char size: %v", err)
+	}
+	if err := face.LoadChar('W', LoadDefault); err != nil {
+		b.Fatalf("unable to load char: %v", err)
+	}
+	outline := face.GlyphSlot().Outline
+
+	dst := image.NewRGBA(image.Rect(0, 0, 300, 300))
+	src := image.NewUniform(color.NRGBA{R: 0x20, G: 0x40, B: 0x80, A: 0xc0})
+
+	b.ReportAllocs()
+	b.StartTimer()
+	for n := 0; n < b.N; n++ {
+		if err := outline.Draw(face.l, dst, image.Pt(10, 250), src, draw.Over); err != nil {
+			b.Fatalf("Outline.Draw() error = %v", err)
+		}
+	}
+}
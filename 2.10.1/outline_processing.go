@@ -1,5 +1,6 @@
 package freetype2
 
+// #include <stdint.h>
 // #include <stdlib.h>
 // #include <ft2build.h>
 // #include FT_IMAGE_H
@@ -11,6 +12,17 @@ package freetype2
 // int OutlineConicToCallback(const FT_Vector* control, const FT_Vector* to, void* user);
 // int OutlineCubicToCallback(const FT_Vector* control1, const FT_Vector* control2, const FT_Vector* to, void* user);
 // void OutlineRenderSpanFunc(int y, int count, const FT_Span* spans, void* user);
+//
+// /* The user handles are table indices, not pointers. They are converted in C,
+//  * where the Go runtime can't mistake them for bad pointers. */
+// static FT_Error outline_decompose(FT_Outline* outline, const FT_Outline_Funcs* funcs, uintptr_t user) {
+// 	return FT_Outline_Decompose(outline, funcs, (void*)user);
+// }
+//
+// static FT_Error outline_render(FT_Library library, FT_Outline* outline, FT_Raster_Params* params, uintptr_t user) {
+// 	params->user = (void*)user;
+// 	return FT_Outline_Render(library, outline, params);
+// }
 import "C"
 
 import (
@@ -27,6 +39,10 @@ import (
 // each contour. The drop-out mode as given with OutlineIgnoreDropouts,
 // OutlineSmartDropouts, and OutlineIncludeStubs in flags is then overridden.
 //
+// The exported fields are a copy of the outline held by FreeType. Edits to them
+// are not seen by FreeType until Commit is called, and are discarded by methods
+// that modify the outline, like Transform, which reload the fields afterwards.
+//
 // See https://www.freetype.org/freetype2/docs/reference/ft2-outline_processing.html#ft_outline
 type Outline struct {
 	ptr         *C.FT_Outline `deep:"-"`
@@ -59,6 +75,18 @@ type Outline struct {
 	Flags OutlineFlag
 }
 
+// Values of bits 0 and 1 of an Outline's tags.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-outline_processing.html#ft_curve_tag
+const (
+	// CurveTagConic marks a second-order Bezier control point.
+	CurveTagConic byte = C.FT_CURVE_TAG_CONIC
+	// CurveTagOn marks a point on the curve.
+	CurveTagOn byte = C.FT_CURVE_TAG_ON
+	// CurveTagCubic marks a third-order Bezier control point.
+	CurveTagCubic byte = C.FT_CURVE_TAG_CUBIC
+)
+
 // NewOutline creates a new outline of a given size.
 //
 // Points must be smaller than or equal to 0xFFFF (65535).
@@ -132,6 +160,15 @@ func (o *Outline) reload() {
 		return
 	}
 
+	// empty outlines, like the ones of blank glyphs, must not keep the arrays
+	// of the previous outline loaded in a glyph slot.
+	if o.ptr.n_points <= 0 {
+		o.Points, o.Tags = nil, nil
+	}
+	if o.ptr.n_contours <= 0 {
+		o.Contours = nil
+	}
+
 	if o.ptr.n_points > 0 {
 		if len(o.Points) != int(o.ptr.n_points) {
 			o.Points = make([]Vector, o.ptr.n_points)
@@ -166,6 +203,73 @@ func (o *Outline) reload() {
 	o.Flags = OutlineFlag(o.ptr.flags)
 }
 
+// Commit copies the edits made to Points, Tags, Contours and Flags back into
+// the outline used by FreeType, so that they are honoured by methods like
+// Transform, Render or BBox.
+//
+// The number of points and contours can't be changed: Points and Tags must
+// have as many elements as the outline has points, and Contours as many as it
+// has contours, otherwise ErrInvalidArgument is returned. It returns
+// ErrInvalidOutline if Contours does not describe valid contour ends, i.e.,
+// strictly increasing indices into Points, the last one being the last point.
+// In both cases the outline is left untouched.
+//
+// The OutlineOwner flag can't be changed, as it tracks who owns the memory of
+// the outline.
+func (o *Outline) Commit() error {
+	if o == nil || o.ptr == nil {
+		return ErrInvalidOutline
+	}
+
+	n := int(o.ptr.n_points)
+	if len(o.Points) != n || len(o.Tags) != n || len(o.Contours) != int(o.ptr.n_contours) {
+		return ErrInvalidArgument
+	}
+
+	end := int16(-1)
+	for _, c := range o.Contours {
+		if c <= end || int(c) >= n {
+			return ErrInvalidOutline
+		}
+		end = c
+	}
+	if len(o.Contours) > 0 && int(end) != n-1 {
+		return ErrInvalidOutline
+	}
+
+	o.store()
+	o.reload()
+	return nil
+}
+
+// store copies the Go fields back into the C outline, whose arrays must have
+// the same lengths. The ownership of the arrays is left untouched.
+func (o *Outline) store() {
+	if len(o.Points) > 0 {
+		ptr := (*[(1<<31 - 1) / C.sizeof_FT_Vector]C.FT_Vector)(unsafe.Pointer(o.ptr.points))[:o.ptr.n_points:o.ptr.n_points]
+		for i, v := range o.Points {
+			ptr[i] = C.FT_Vector{x: C.FT_Pos(v.X), y: C.FT_Pos(v.Y)}
+		}
+	}
+
+	if len(o.Tags) > 0 {
+		ptr := (*[(1<<31 - 1) / C.sizeof_char]C.char)(unsafe.Pointer(o.ptr.tags))[:o.ptr.n_points:o.ptr.n_points]
+		for i, t := range o.Tags {
+			ptr[i] = C.char(t)
+		}
+	}
+
+	if len(o.Contours) > 0 {
+		ptr := (*[(1<<31 - 1) / C.sizeof_short]C.short)(unsafe.Pointer(o.ptr.contours))[:o.ptr.n_contours:o.ptr.n_contours]
+		for i, c := range o.Contours {
+			ptr[i] = C.short(c)
+		}
+	}
+
+	owner := OutlineFlag(o.ptr.flags) & OutlineOwner
+	o.ptr.flags = C.int(o.Flags&^OutlineOwner | owner)
+}
+
 // CopyTo copies an outline into another one.
 // Both objects must have the same sizes (number of points & number of contours)
 // when this function is called.
@@ -473,12 +577,12 @@ func (o *Outline) Render(l *Library, p RasterParams) error {
 	if p.Target != nil {
 		target = p.Target.ptr
 	}
+	// source is set by FT_Outline_Render, o.ptr can't be stored here as it
+	// may point to Go memory.
 	params := C.FT_Raster_Params{
 		target:     target,
-		source:     unsafe.Pointer(o.ptr),
 		flags:      C.int(p.Flags),
 		gray_spans: (*[0]byte)(C.OutlineRenderSpanFunc),
-		user:       unsafe.Pointer(handle),
 		clip_box: C.FT_BBox{
 			xMin: C.FT_Pos(p.ClipBox.XMin),
 			xMax: C.FT_Pos(p.ClipBox.XMax),
@@ -487,7 +591,7 @@ func (o *Outline) Render(l *Library, p RasterParams) error {
 		},
 	}
 
-	return getErr(C.FT_Outline_Render(l.ptr, o.ptr, &params))
+	return getErr(C.outline_render(l.ptr, o.ptr, &params, C.uintptr_t(handle)))
 }
 
 // OutlineDecomposer is used during outline decomposition in order to emit
@@ -597,7 +701,7 @@ func (o *Outline) Decompose(decomposer OutlineDecomposer, shift int, delta Pos)
 	}
 	defer decomposers.release(handle)
 
-	return getErr(C.FT_Outline_Decompose(o.ptr, funcs, unsafe.Pointer(handle)))
+	return getErr(C.outline_decompose(o.ptr, funcs, C.uintptr_t(handle)))
 }
 
 // Orientation is used to describe an outline's contour orientation.
@@ -47,6 +47,33 @@ func Test_Outline_reload(t *testing.T) {
 	}
 }
 
+func Test_Outline_reload_empty(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set char size: %v", err)
+	}
+
+	if err := face.LoadChar('A', LoadDefault); err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+	if got := face.GlyphSlot().Outline; len(got.Points) == 0 || len(got.Tags) == 0 || len(got.Contours) == 0 {
+		t.Fatalf("GlyphSlot().Outline is empty")
+	}
+
+	if err := face.LoadChar(' ', LoadDefault); err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+	got := face.GlyphSlot().Outline
+	if got.Points != nil || got.Tags != nil || got.Contours != nil {
+		t.Errorf("Outline.reload() = %v, %v, %v, want nil", got.Points, got.Tags, got.Contours)
+	}
+}
+
 func TestOutlineFlag_String(t *testing.T) {
 	tests := []struct {
 		name string
@@ -2548,6 +2575,83 @@ func TestOutlineDecompose(t *testing.T) {
 	}
 }
 
+// useStack calls f with about n*64 bytes of stack in use.
+//
+//go:noinline
+func useStack(n int, f func()) byte {
+	var buf [64]byte
+	buf[n%len(buf)] = byte(n)
+	if n == 0 {
+		f()
+		return buf[0]
+	}
+	return useStack(n-1, f) + buf[n%len(buf)]
+}
+
+type growingDecomposer struct {
+	stackDecomposer
+}
+
+func (d *growingDecomposer) MoveTo(to Vector) error {
+	useStack(1024, func() {})
+	return d.stackDecomposer.MoveTo(to)
+}
+
+// The handles of the callbacks are small integers, the runtime must not find
+// them in pointer slots when it grows the stack, before calling FreeType or
+// from the callbacks.
+func TestOutline_callbacksGrowStack(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set char size: %v", err)
+	}
+	if err := face.LoadChar('A', LoadDefault); err != nil {
+		t.Fatalf("unable to load char: %v", err)
+	}
+	outline := face.GlyphSlot().Outline
+
+	call := func() {
+		d := &growingDecomposer{}
+		if err := outline.Decompose(d, 0, 0); err != nil {
+			t.Errorf("Outline.Decompose() error = %v", err)
+		}
+		if len(d.stack) == 0 {
+			t.Errorf("Outline.Decompose() emitted nothing")
+		}
+
+		spans := 0
+		err := outline.Render(face.l, RasterParams{
+			Flags: RasterFlagAA | RasterFlagDirect,
+			GraySpans: func(y int, s []Span) {
+				useStack(1024, func() {})
+				spans += len(s)
+			},
+		})
+		if err != nil {
+			t.Errorf("Outline.Render() error = %v", err)
+		}
+		if spans == 0 {
+			t.Errorf("Outline.Render() drew no spans")
+		}
+	}
+
+	// new goroutines start with a small stack, which has to grow at some
+	// point of the calls made at one of these depths.
+	for n := 0; n < 256; n++ {
+		done := make(chan struct{})
+		go func(n int) {
+			defer close(done)
+			useStack(n, call)
+		}(n)
+		<-done
+	}
+}
+
 func Test_Outline_Free(t *testing.T) {
 	var nilOutline *Outline
 	if err := nilOutline.Free(); err != nil {
@@ -2663,3 +2767,173 @@ func Test_Outline_Free(t *testing.T) {
 		t.Errorf("%v", diff)
 	}
 }
+
+func TestOutline_Commit(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to create lib: %v", err)
+	}
+	defer l.Free()
+
+	var nilOutline *Outline
+	if err := nilOutline.Commit(); err != ErrInvalidOutline {
+		t.Errorf("Outline.Commit() error = %v, want %v", err, ErrInvalidOutline)
+	}
+
+	newTriangle := func() *Outline {
+		var b OutlineBuilder
+		b.MoveTo(Vector{X: 0, Y: 0})
+		b.LineTo(Vector{X: 64, Y: 0})
+		b.LineTo(Vector{X: 0, Y: 64})
+		o, err := b.Outline(l)
+		if err != nil {
+			t.Fatalf("unable to build outline: %v", err)
+		}
+		return o
+	}
+
+	tests := []struct {
+		name     string
+		edit     func(o *Outline)
+		wantErr  error
+		wantCBox BBox
+	}{
+		{
+			name:     "unchanged",
+			edit:     func(o *Outline) {},
+			wantCBox: BBox{XMax: 64, YMax: 64},
+		},
+		{
+			name: "points",
+			edit: func(o *Outline) {
+				for i := range o.Points {
+					o.Points[i].X += 128
+					o.Points[i].Y *= 2
+				}
+			},
+			wantCBox: BBox{XMin: 128, XMax: 192, YMax: 128},
+		},
+		{
+			name:     "too many points",
+			edit:     func(o *Outline) { o.Points = append(o.Points, Vector{X: 256, Y: 256}) },
+			wantErr:  ErrInvalidArgument,
+			wantCBox: BBox{XMax: 64, YMax: 64},
+		},
+		{
+			name:     "too few tags",
+			edit:     func(o *Outline) { o.Points[0].X = -64; o.Tags = o.Tags[:2] },
+			wantErr:  ErrInvalidArgument,
+			wantCBox: BBox{XMax: 64, YMax: 64},
+		},
+		{
+			name:     "too many contours",
+			edit:     func(o *Outline) { o.Contours = []int16{0, 2} },
+			wantErr:  ErrInvalidArgument,
+			wantCBox: BBox{XMax: 64, YMax: 64},
+		},
+		{
+			name:     "contour out of range",
+			edit:     func(o *Outline) { o.Points[0].X = -64; o.Contours[0] = 3 },
+			wantErr:  ErrInvalidOutline,
+			wantCBox: BBox{XMax: 64, YMax: 64},
+		},
+		{
+			name:     "contour not ending at the last point",
+			edit:     func(o *Outline) { o.Contours[0] = 1 },
+			wantErr:  ErrInvalidOutline,
+			wantCBox: BBox{XMax: 64, YMax: 64},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newTriangle()
+			defer o.Free()
+
+			tt.edit(o)
+			if err := o.Commit(); err != tt.wantErr {
+				t.Fatalf("Outline.Commit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := o.CBox(); got != tt.wantCBox {
+				t.Errorf("Outline.CBox() = %v, want %v", got, tt.wantCBox)
+			}
+			if !o.Check() {
+				t.Errorf("Outline.Check() = false, want true")
+			}
+		})
+	}
+
+	t.Run("flags", func(t *testing.T) {
+		o := newTriangle()
+		defer o.Free()
+
+		o.Flags = OutlineEvenOddFill
+		if err := o.Commit(); err != nil {
+			t.Fatalf("Outline.Commit() error = %v", err)
+		}
+
+		// the owner flag is kept, so that the points are released by Free.
+		if want := OutlineOwner | OutlineEvenOddFill; o.Flags != want {
+			t.Errorf("Outline.Flags = %v, want %v", o.Flags, want)
+		}
+		if got := OutlineFlag(o.ptr.flags); got != OutlineOwner|OutlineEvenOddFill {
+			t.Errorf("Outline.Commit() flags = %v, want %v", got, OutlineOwner|OutlineEvenOddFill)
+		}
+	})
+
+	t.Run("transform keeps edits", func(t *testing.T) {
+		o := newTriangle()
+		defer o.Free()
+
+		o.Points[1].X = 128
+		if err := o.Commit(); err != nil {
+			t.Fatalf("Outline.Commit() error = %v", err)
+		}
+
+		o.Translate(64, 0)
+		want := []Vector{{X: 64, Y: 0}, {X: 192, Y: 0}, {X: 64, Y: 64}}
+		if diff := diff(o.Points, want); diff != nil {
+			t.Errorf("Outline.Translate() = %v", diff)
+		}
+	})
+}
+
+func TestOutline_Commit_glyphSlot(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set char size: %v", err)
+	}
+
+	if err := face.LoadChar('A', LoadDefault); err != nil {
+		t.Fatalf("unable to load char: %v", err)
+	}
+
+	slot := face.GlyphSlot()
+	cbox := slot.Outline.CBox()
+
+	// stretch the glyph horizontally before rendering it.
+	for i := range slot.Outline.Points {
+		slot.Outline.Points[i].X *= 2
+	}
+	if err := slot.Outline.Commit(); err != nil {
+		t.Fatalf("Outline.Commit() error = %v", err)
+	}
+
+	want := BBox{XMin: 2 * cbox.XMin, YMin: cbox.YMin, XMax: 2 * cbox.XMax, YMax: cbox.YMax}
+	if got := slot.Outline.CBox(); got != want {
+		t.Errorf("Outline.CBox() = %v, want %v", got, want)
+	}
+
+	if err := slot.RenderGlyph(RenderModeNormal); err != nil {
+		t.Fatalf("unable to render glyph: %v", err)
+	}
+
+	if got, want := slot.Bitmap.Width, int((want.XMax+63)>>6-want.XMin>>6); got != want {
+		t.Errorf("GlyphSlot.Bitmap.Width = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,669 @@
+package freetype2
+
+// #include <stdlib.h>
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include FT_MODULE_H
+// #include FT_DRIVER_H
+import "C"
+
+import (
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// Module is the name of a FreeType module or driver that exposes properties.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html
+type Module string
+
+const (
+	// ModuleAutofitter is the auto-hinter module.
+	ModuleAutofitter Module = "autofitter"
+	// ModuleCFF is the CFF driver.
+	ModuleCFF Module = "cff"
+	// ModuleType1 is the Type 1 driver.
+	ModuleType1 Module = "type1"
+	// ModuleT1CID is the CID-keyed Type 1 driver.
+	ModuleT1CID Module = "t1cid"
+	// ModuleTrueType is the TrueType driver.
+	ModuleTrueType Module = "truetype"
+)
+
+// Property is a driver or module property. It is implemented by all the
+// property types in this package, pointers to those types can be used to read
+// properties back.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html
+type Property interface {
+	// propertyName returns the name FreeType uses for the property.
+	propertyName() string
+	// value returns a pointer to the C representation of the property.
+	value() (unsafe.Pointer, error)
+}
+
+// propertyLoader is implemented by pointers to properties.
+type propertyLoader interface {
+	Property
+	// load reads the C representation of the property.
+	load(unsafe.Pointer)
+}
+
+// SetProperty sets a property for the given module.
+//
+// Note that only a few modules have properties, and properties are module
+// specific, setting an unknown property returns ErrMissingProperty and an
+// unknown module returns ErrMissingModule.
+//
+// Properties should be set before any face is created, changing them
+// afterwards has an undefined effect on already loaded faces and sizes.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-module_management.html#ft_property_set
+func (l *Library) SetProperty(module Module, p Property) error {
+	if l == nil || l.ptr == nil {
+		return ErrInvalidLibraryHandle
+	}
+
+	if p == nil {
+		return ErrInvalidArgument
+	}
+
+	v, err := p.value()
+	if err != nil {
+		return err
+	}
+
+	cmodule := C.CString(string(module))
+	defer C.free(unsafe.Pointer(cmodule))
+	cname := C.CString(p.propertyName())
+	defer C.free(unsafe.Pointer(cname))
+
+	return getErr(C.FT_Property_Set(l.ptr, cmodule, cname, v))
+}
+
+// Property reads a property of the given module into p, which must be a
+// pointer to one of the property types, otherwise ErrInvalidArgument is
+// returned.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-module_management.html#ft_property_get
+func (l *Library) Property(module Module, p Property) error {
+	if l == nil || l.ptr == nil {
+		return ErrInvalidLibraryHandle
+	}
+
+	loader, ok := p.(propertyLoader)
+	if !ok {
+		return ErrInvalidArgument
+	}
+
+	v, err := loader.value()
+	if err != nil {
+		return err
+	}
+
+	cmodule := C.CString(string(module))
+	defer C.free(unsafe.Pointer(cmodule))
+	cname := C.CString(p.propertyName())
+	defer C.free(unsafe.Pointer(cname))
+
+	if err := getErr(C.FT_Property_Get(l.ptr, cmodule, cname, v)); err != nil {
+		return err
+	}
+
+	loader.load(v)
+	return nil
+}
+
+// InterpreterVersion is the ‘interpreter-version’ property of the truetype
+// driver. It selects the bytecode interpreter used to hint TrueType fonts.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html#interpreter-version
+type InterpreterVersion uint
+
+const (
+	// InterpreterVersion35 is the version of the TrueType interpreter that
+	// corresponds to the original TrueType hinting instructions for
+	// monochrome rendering.
+	InterpreterVersion35 InterpreterVersion = C.TT_INTERPRETER_VERSION_35
+	// InterpreterVersion38 is the Infinality subpixel hinting interpreter, it
+	// is only available if FreeType was built with it.
+	InterpreterVersion38 InterpreterVersion = C.TT_INTERPRETER_VERSION_38
+	// InterpreterVersion40 is the minimal subpixel hinting interpreter, which
+	// ignores hinting instructions along the x axis. It is the default.
+	InterpreterVersion40 InterpreterVersion = C.TT_INTERPRETER_VERSION_40
+)
+
+func (v InterpreterVersion) String() string {
+	switch v {
+	case InterpreterVersion35:
+		return "35"
+	case InterpreterVersion38:
+		return "38"
+	case InterpreterVersion40:
+		return "40"
+	default:
+		return "Unknown"
+	}
+}
+
+func (v InterpreterVersion) propertyName() string { return "interpreter-version" }
+
+func (v InterpreterVersion) value() (unsafe.Pointer, error) {
+	cv := C.FT_UInt(v)
+	return unsafe.Pointer(&cv), nil
+}
+
+func (v *InterpreterVersion) load(p unsafe.Pointer) {
+	*v = InterpreterVersion(*(*C.FT_UInt)(p))
+}
+
+// HintingEngine is the ‘hinting-engine’ property of the cff, type1 and t1cid
+// drivers. It selects the engine used to hint CFF and Type 1 fonts.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html#hinting-engine
+type HintingEngine uint
+
+const (
+	// HintingFreeType uses the old FreeType hinting engine. It is only
+	// available if FreeType was built with the old engines enabled, which is
+	// not the case of the bundled static libraries.
+	HintingFreeType HintingEngine = C.FT_HINTING_FREETYPE
+	// HintingAdobe uses the hinting engine contributed by Adobe.
+	HintingAdobe HintingEngine = C.FT_HINTING_ADOBE
+)
+
+func (v HintingEngine) String() string {
+	switch v {
+	case HintingFreeType:
+		return "FreeType"
+	case HintingAdobe:
+		return "Adobe"
+	default:
+		return "Unknown"
+	}
+}
+
+func (v HintingEngine) propertyName() string { return "hinting-engine" }
+
+func (v HintingEngine) value() (unsafe.Pointer, error) {
+	cv := C.FT_UInt(v)
+	return unsafe.Pointer(&cv), nil
+}
+
+func (v *HintingEngine) load(p unsafe.Pointer) {
+	*v = HintingEngine(*(*C.FT_UInt)(p))
+}
+
+// NoStemDarkening is the ‘no-stem-darkening’ property of the cff, type1, t1cid
+// and autofitter modules. When false, stems are emboldened at small sizes to
+// improve their rendering when using linear alpha blending and gamma
+// correction.
+//
+// Stem darkening is off by default.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html#no-stem-darkening
+type NoStemDarkening bool
+
+func (v NoStemDarkening) propertyName() string { return "no-stem-darkening" }
+
+func (v NoStemDarkening) value() (unsafe.Pointer, error) {
+	var cv C.FT_Bool
+	if v {
+		cv = 1
+	}
+	return unsafe.Pointer(&cv), nil
+}
+
+func (v *NoStemDarkening) load(p unsafe.Pointer) {
+	*v = *(*C.FT_Bool)(p) != 0
+}
+
+// DarkeningParameters is the ‘darkening-parameters’ property of the cff,
+// type1, t1cid and autofitter modules. It holds the four control points
+// x1, y1, x2, y2, x3, y3, x4, y4 of the piecewise linear function that maps a
+// stem width (in font units scaled to 1000 units per em) to the darkening
+// amount.
+//
+// The x values must be monotonically increasing, and all values must be
+// non-negative.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html#darkening-parameters
+type DarkeningParameters [8]int
+
+func (v DarkeningParameters) propertyName() string { return "darkening-parameters" }
+
+func (v DarkeningParameters) value() (unsafe.Pointer, error) {
+	var cv [8]C.FT_Int
+	for i := range v {
+		cv[i] = C.FT_Int(v[i])
+	}
+	return unsafe.Pointer(&cv), nil
+}
+
+func (v *DarkeningParameters) load(p unsafe.Pointer) {
+	cv := (*[8]C.FT_Int)(p)
+	for i := range cv {
+		v[i] = int(cv[i])
+	}
+}
+
+// AutohinterScript is the list of scripts covered by the autofitter, it is
+// used by the DefaultScript and FallbackScript properties.
+//
+// The values mirror the autofitter's internal script enumeration of FreeType
+// 2.10.1, which differs from the deprecated FT_AUTOHINTER_SCRIPT_XXX
+// constants.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html#default-script
+type AutohinterScript uint
+
+const (
+	// AutohinterScriptAdlam is the Adlam script.
+	AutohinterScriptAdlam AutohinterScript = iota
+	// AutohinterScriptArabic is the Arabic script.
+	AutohinterScriptArabic
+	// AutohinterScriptArmenian is the Armenian script.
+	AutohinterScriptArmenian
+	// AutohinterScriptAvestan is the Avestan script.
+	AutohinterScriptAvestan
+	// AutohinterScriptBamum is the Bamum script.
+	AutohinterScriptBamum
+	// AutohinterScriptBengali is the Bengali script.
+	AutohinterScriptBengali
+	// AutohinterScriptBuhid is the Buhid script.
+	AutohinterScriptBuhid
+	// AutohinterScriptChakma is the Chakma script.
+	AutohinterScriptChakma
+	// AutohinterScriptCanadianSyllabics is the Canadian Syllabics script.
+	AutohinterScriptCanadianSyllabics
+	// AutohinterScriptCarian is the Carian script.
+	AutohinterScriptCarian
+	// AutohinterScriptCherokee is the Cherokee script.
+	AutohinterScriptCherokee
+	// AutohinterScriptCoptic is the Coptic script.
+	AutohinterScriptCoptic
+	// AutohinterScriptCypriot is the Cypriot script.
+	AutohinterScriptCypriot
+	// AutohinterScriptCyrillic is the Cyrillic script.
+	AutohinterScriptCyrillic
+	// AutohinterScriptDevanagari is the Devanagari script.
+	AutohinterScriptDevanagari
+	// AutohinterScriptDeseret is the Deseret script.
+	AutohinterScriptDeseret
+	// AutohinterScriptEthiopic is the Ethiopic script.
+	AutohinterScriptEthiopic
+	// AutohinterScriptGeorgian is the Georgian (Mkhedruli) script.
+	AutohinterScriptGeorgian
+	// AutohinterScriptGeorgianKhutsuri is the Georgian (Khutsuri) script.
+	AutohinterScriptGeorgianKhutsuri
+	// AutohinterScriptGlagolitic is the Glagolitic script.
+	AutohinterScriptGlagolitic
+	// AutohinterScriptGothic is the Gothic script.
+	AutohinterScriptGothic
+	// AutohinterScriptGreek is the Greek script.
+	AutohinterScriptGreek
+	// AutohinterScriptGujarati is the Gujarati script.
+	AutohinterScriptGujarati
+	// AutohinterScriptGurmukhi is the Gurmukhi script.
+	AutohinterScriptGurmukhi
+	// AutohinterScriptHebrew is the Hebrew script.
+	AutohinterScriptHebrew
+	// AutohinterScriptKayahLi is the Kayah Li script.
+	AutohinterScriptKayahLi
+	// AutohinterScriptKhmer is the Khmer script.
+	AutohinterScriptKhmer
+	// AutohinterScriptKhmerSymbols is the Khmer Symbols script.
+	AutohinterScriptKhmerSymbols
+	// AutohinterScriptKannada is the Kannada script.
+	AutohinterScriptKannada
+	// AutohinterScriptLao is the Lao script.
+	AutohinterScriptLao
+	// AutohinterScriptLatin is the Latin script.
+	AutohinterScriptLatin
+	// AutohinterScriptLatinSubscript is the Latin Subscript Fallback script.
+	AutohinterScriptLatinSubscript
+	// AutohinterScriptLatinSuperscript is the Latin Superscript Fallback script.
+	AutohinterScriptLatinSuperscript
+	// AutohinterScriptLisu is the Lisu script.
+	AutohinterScriptLisu
+	// AutohinterScriptMalayalam is the Malayalam script.
+	AutohinterScriptMalayalam
+	// AutohinterScriptMongolian is the Mongolian script.
+	AutohinterScriptMongolian
+	// AutohinterScriptMyanmar is the Myanmar script.
+	AutohinterScriptMyanmar
+	// AutohinterScriptNKo is the N'Ko script.
+	AutohinterScriptNKo
+	// AutohinterScriptNone means the glyphs are not auto-hinted.
+	AutohinterScriptNone
+	// AutohinterScriptOlChiki is the Ol Chiki script.
+	AutohinterScriptOlChiki
+	// AutohinterScriptOldTurkic is the Old Turkic script.
+	AutohinterScriptOldTurkic
+	// AutohinterScriptOsage is the Osage script.
+	AutohinterScriptOsage
+	// AutohinterScriptOsmanya is the Osmanya script.
+	AutohinterScriptOsmanya
+	// AutohinterScriptSaurashtra is the Saurashtra script.
+	AutohinterScriptSaurashtra
+	// AutohinterScriptShavian is the Shavian script.
+	AutohinterScriptShavian
+	// AutohinterScriptSinhala is the Sinhala script.
+	AutohinterScriptSinhala
+	// AutohinterScriptSundanese is the Sundanese script.
+	AutohinterScriptSundanese
+	// AutohinterScriptTamil is the Tamil script.
+	AutohinterScriptTamil
+	// AutohinterScriptTaiViet is the Tai Viet script.
+	AutohinterScriptTaiViet
+	// AutohinterScriptTelugu is the Telugu script.
+	AutohinterScriptTelugu
+	// AutohinterScriptTifinagh is the Tifinagh script.
+	AutohinterScriptTifinagh
+	// AutohinterScriptThai is the Thai script.
+	AutohinterScriptThai
+	// AutohinterScriptVai is the Vai script.
+	AutohinterScriptVai
+	// AutohinterScriptLimbu is the Limbu script.
+	AutohinterScriptLimbu
+	// AutohinterScriptOriya is the Oriya script.
+	AutohinterScriptOriya
+	// AutohinterScriptSylotiNagri is the Syloti Nagri script.
+	AutohinterScriptSylotiNagri
+	// AutohinterScriptTibetan is the Tibetan script.
+	AutohinterScriptTibetan
+	// AutohinterScriptCJK is the CJKV ideographs script.
+	AutohinterScriptCJK
+)
+
+// autohinterScriptTags holds the ISO 15924 tag of each AutohinterScript.
+var autohinterScriptTags = [...]string{
+	AutohinterScriptAdlam:             "adlm",
+	AutohinterScriptArabic:            "arab",
+	AutohinterScriptArmenian:          "armn",
+	AutohinterScriptAvestan:           "avst",
+	AutohinterScriptBamum:             "bamu",
+	AutohinterScriptBengali:           "beng",
+	AutohinterScriptBuhid:             "buhd",
+	AutohinterScriptChakma:            "cakm",
+	AutohinterScriptCanadianSyllabics: "cans",
+	AutohinterScriptCarian:            "cari",
+	AutohinterScriptCherokee:          "cher",
+	AutohinterScriptCoptic:            "copt",
+	AutohinterScriptCypriot:           "cprt",
+	AutohinterScriptCyrillic:          "cyrl",
+	AutohinterScriptDevanagari:        "deva",
+	AutohinterScriptDeseret:           "dsrt",
+	AutohinterScriptEthiopic:          "ethi",
+	AutohinterScriptGeorgian:          "geor",
+	AutohinterScriptGeorgianKhutsuri:  "geok",
+	AutohinterScriptGlagolitic:        "glag",
+	AutohinterScriptGothic:            "goth",
+	AutohinterScriptGreek:             "grek",
+	AutohinterScriptGujarati:          "gujr",
+	AutohinterScriptGurmukhi:          "guru",
+	AutohinterScriptHebrew:            "hebr",
+	AutohinterScriptKayahLi:           "kali",
+	AutohinterScriptKhmer:             "khmr",
+	AutohinterScriptKhmerSymbols:      "khms",
+	AutohinterScriptKannada:           "knda",
+	AutohinterScriptLao:               "lao",
+	AutohinterScriptLatin:             "latn",
+	AutohinterScriptLatinSubscript:    "latb",
+	AutohinterScriptLatinSuperscript:  "latp",
+	AutohinterScriptLisu:              "lisu",
+	AutohinterScriptMalayalam:         "mlym",
+	AutohinterScriptMongolian:         "mong",
+	AutohinterScriptMyanmar:           "mymr",
+	AutohinterScriptNKo:               "nkoo",
+	AutohinterScriptNone:              "none",
+	AutohinterScriptOlChiki:           "olck",
+	AutohinterScriptOldTurkic:         "orkh",
+	AutohinterScriptOsage:             "osge",
+	AutohinterScriptOsmanya:           "osma",
+	AutohinterScriptSaurashtra:        "saur",
+	AutohinterScriptShavian:           "shaw",
+	AutohinterScriptSinhala:           "sinh",
+	AutohinterScriptSundanese:         "sund",
+	AutohinterScriptTamil:             "taml",
+	AutohinterScriptTaiViet:           "tavt",
+	AutohinterScriptTelugu:            "telu",
+	AutohinterScriptTifinagh:          "tfng",
+	AutohinterScriptThai:              "thai",
+	AutohinterScriptVai:               "vaii",
+	AutohinterScriptLimbu:             "limb",
+	AutohinterScriptOriya:             "orya",
+	AutohinterScriptSylotiNagri:       "sylo",
+	AutohinterScriptTibetan:           "tibt",
+	AutohinterScriptCJK:               "hani",
+}
+
+// String returns the ISO 15924 tag of the script, as used by FreeType.
+func (v AutohinterScript) String() string {
+	if int(v) < len(autohinterScriptTags) {
+		return autohinterScriptTags[v]
+	}
+	return "Unknown"
+}
+
+// DefaultScript is the ‘default-script’ property of the autofitter. When
+// FreeType is built with HarfBuzz, it is the script used for the default
+// OpenType script data of a font's GSUB table. It defaults to
+// AutohinterScriptLatin.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html#default-script
+type DefaultScript AutohinterScript
+
+func (v DefaultScript) String() string { return AutohinterScript(v).String() }
+
+func (v DefaultScript) propertyName() string { return "default-script" }
+
+func (v DefaultScript) value() (unsafe.Pointer, error) {
+	cv := C.FT_UInt(v)
+	return unsafe.Pointer(&cv), nil
+}
+
+func (v *DefaultScript) load(p unsafe.Pointer) {
+	*v = DefaultScript(*(*C.FT_UInt)(p))
+}
+
+// FallbackScript is the ‘fallback-script’ property of the autofitter. It is
+// the script assigned to glyphs that no auto-hinter script module can handle,
+// it defaults to AutohinterScriptCJK.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html#fallback-script
+type FallbackScript AutohinterScript
+
+func (v FallbackScript) String() string { return AutohinterScript(v).String() }
+
+func (v FallbackScript) propertyName() string { return "fallback-script" }
+
+func (v FallbackScript) value() (unsafe.Pointer, error) {
+	cv := C.FT_UInt(v)
+	return unsafe.Pointer(&cv), nil
+}
+
+func (v *FallbackScript) load(p unsafe.Pointer) {
+	*v = FallbackScript(*(*C.FT_UInt)(p))
+}
+
+// IncreaseXHeight is the ‘increase-x-height’ property of the autofitter. For
+// ppem values in the range 6 <= ppem <= Limit, the x-height of Face is rounded
+// up more often than normally, to improve the legibility of small font sizes.
+// A Limit of 0 (the default) disables the feature.
+//
+// When reading the property, Face must be set.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html#increase-x-height
+type IncreaseXHeight struct {
+	Face  *Face
+	Limit uint
+}
+
+func (v IncreaseXHeight) propertyName() string { return "increase-x-height" }
+
+func (v IncreaseXHeight) value() (unsafe.Pointer, error) {
+	if v.Face == nil || v.Face.ptr == nil {
+		return nil, ErrInvalidFaceHandle
+	}
+
+	cv := C.FT_Prop_IncreaseXHeight{
+		face:  v.Face.ptr,
+		limit: C.FT_UInt(v.Limit),
+	}
+	return unsafe.Pointer(&cv), nil
+}
+
+func (v *IncreaseXHeight) load(p unsafe.Pointer) {
+	v.Limit = uint((*C.FT_Prop_IncreaseXHeight)(p).limit)
+}
+
+// Warping is the ‘warping’ property of the autofitter. When enabled, glyphs
+// are horizontally scaled and shifted to better align their stems with the
+// pixel grid, which can also change advance widths.
+//
+// Warping is off by default.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html#warping
+type Warping bool
+
+func (v Warping) propertyName() string { return "warping" }
+
+func (v Warping) value() (unsafe.Pointer, error) {
+	var cv C.FT_Bool
+	if v {
+		cv = 1
+	}
+	return unsafe.Pointer(&cv), nil
+}
+
+func (v *Warping) load(p unsafe.Pointer) {
+	*v = *(*C.FT_Bool)(p) != 0
+}
+
+// ModuleProperty pairs a Property with the module it applies to.
+type ModuleProperty struct {
+	Module   Module
+	Property Property
+}
+
+// ParseProperties parses a string using the syntax of the FREETYPE_PROPERTIES
+// environment variable, a whitespace separated list of
+// ‘module:property=value’ entries, for example
+//
+//	truetype:interpreter-version=35 cff:no-stem-darkening=0 autofitter:warping=1
+//
+// Values use the same representation as FreeType: numbers for
+// interpreter-version, 0 or 1 for boolean properties, ‘adobe’ or ‘freetype’ for
+// hinting-engine, and a comma separated list of 8 numbers for
+// darkening-parameters. In addition, default-script and fallback-script accept
+// the ISO 15924 tag of a script, like ‘latn’ or ‘hani’.
+//
+// The module names are not validated, but unknown property names return
+// ErrMissingProperty and malformed entries or values return
+// ErrInvalidArgument. increase-x-height can't be represented as it applies to a
+// single face.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-properties.html
+func ParseProperties(s string) ([]ModuleProperty, error) {
+	var ret []ModuleProperty
+	for _, entry := range strings.Fields(s) {
+		colon := strings.IndexByte(entry, ':')
+		if colon <= 0 {
+			return nil, ErrInvalidArgument
+		}
+		module, rest := Module(entry[:colon]), entry[colon+1:]
+
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 || eq == len(rest)-1 {
+			return nil, ErrInvalidArgument
+		}
+
+		p, err := parseProperty(rest[:eq], rest[eq+1:])
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, ModuleProperty{Module: module, Property: p})
+	}
+
+	return ret, nil
+}
+
+func parseProperty(name, value string) (Property, error) {
+	switch name {
+	case "interpreter-version":
+		v, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		return InterpreterVersion(v), nil
+
+	case "hinting-engine":
+		switch value {
+		case "adobe":
+			return HintingAdobe, nil
+		case "freetype":
+			return HintingFreeType, nil
+		default:
+			return nil, ErrInvalidArgument
+		}
+
+	case "no-stem-darkening":
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		return NoStemDarkening(v != 0), nil
+
+	case "darkening-parameters":
+		parts := strings.Split(value, ",")
+		if len(parts) != 8 {
+			return nil, ErrInvalidArgument
+		}
+
+		var v DarkeningParameters
+		for i, part := range parts {
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, ErrInvalidArgument
+			}
+			v[i] = n
+		}
+		return v, nil
+
+	case "default-script":
+		v, err := parseAutohinterScript(value)
+		if err != nil {
+			return nil, err
+		}
+		return DefaultScript(v), nil
+
+	case "fallback-script":
+		v, err := parseAutohinterScript(value)
+		if err != nil {
+			return nil, err
+		}
+		return FallbackScript(v), nil
+
+	case "warping":
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		return Warping(v != 0), nil
+
+	default:
+		return nil, ErrMissingProperty
+	}
+}
+
+func parseAutohinterScript(s string) (AutohinterScript, error) {
+	for i, tag := range autohinterScriptTags {
+		if tag == s {
+			return AutohinterScript(i), nil
+		}
+	}
+	return 0, ErrInvalidArgument
+}
@@ -0,0 +1,264 @@
+package freetype2
+
+import (
+	"testing"
+)
+
+func TestLibrary_Property(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	var (
+		interpreterVersion InterpreterVersion
+		hintingEngine      HintingEngine
+		noStemDarkening    NoStemDarkening
+		darkening          DarkeningParameters
+		defaultScript      DefaultScript
+		fallbackScript     FallbackScript
+		warping            Warping
+		increaseXHeight    = IncreaseXHeight{Face: face.Face}
+	)
+
+	tests := []struct {
+		name    string
+		lib     *Library
+		module  Module
+		p       Property
+		want    Property
+		wantErr error
+	}{
+		{name: "nil lib", lib: nil, module: ModuleTrueType, p: &interpreterVersion, want: &interpreterVersion, wantErr: ErrInvalidLibraryHandle},
+		{name: "not a pointer", lib: face.l, module: ModuleTrueType, p: InterpreterVersion40, want: InterpreterVersion40, wantErr: ErrInvalidArgument},
+		{name: "nil", lib: face.l, module: ModuleTrueType, p: nil, want: nil, wantErr: ErrInvalidArgument},
+		{name: "unknown module", lib: face.l, module: "foo", p: &warping, want: &warping, wantErr: ErrMissingModule},
+		{name: "unknown property", lib: face.l, module: ModuleTrueType, p: &warping, want: &warping, wantErr: ErrMissingProperty},
+		{name: "increase-x-height nil face", lib: face.l, module: ModuleAutofitter, p: &IncreaseXHeight{}, want: &IncreaseXHeight{}, wantErr: ErrInvalidFaceHandle},
+
+		{name: "truetype interpreter-version", lib: face.l, module: ModuleTrueType, p: &interpreterVersion, want: ptrInterpreterVersion(InterpreterVersion40), wantErr: nil},
+		{name: "cff hinting-engine", lib: face.l, module: ModuleCFF, p: &hintingEngine, want: ptrHintingEngine(HintingAdobe), wantErr: nil},
+		{name: "type1 hinting-engine", lib: face.l, module: ModuleType1, p: &hintingEngine, want: ptrHintingEngine(HintingAdobe), wantErr: nil},
+		{name: "t1cid hinting-engine", lib: face.l, module: ModuleT1CID, p: &hintingEngine, want: ptrHintingEngine(HintingAdobe), wantErr: nil},
+		{name: "cff no-stem-darkening", lib: face.l, module: ModuleCFF, p: &noStemDarkening, want: ptrNoStemDarkening(true), wantErr: nil},
+		{name: "autofitter no-stem-darkening", lib: face.l, module: ModuleAutofitter, p: &noStemDarkening, want: ptrNoStemDarkening(true), wantErr: nil},
+		{name: "cff darkening-parameters", lib: face.l, module: ModuleCFF, p: &darkening, want: &DarkeningParameters{500, 400, 1000, 275, 1667, 275, 2333, 0}, wantErr: nil},
+		{name: "autofitter darkening-parameters", lib: face.l, module: ModuleAutofitter, p: &darkening, want: &DarkeningParameters{500, 400, 1000, 275, 1667, 275, 2333, 0}, wantErr: nil},
+		{name: "autofitter default-script", lib: face.l, module: ModuleAutofitter, p: &defaultScript, want: ptrDefaultScript(DefaultScript(AutohinterScriptLatin)), wantErr: nil},
+		{name: "autofitter fallback-script", lib: face.l, module: ModuleAutofitter, p: &fallbackScript, want: ptrFallbackScript(FallbackScript(AutohinterScriptCJK)), wantErr: nil},
+		{name: "autofitter warping", lib: face.l, module: ModuleAutofitter, p: &warping, want: ptrWarping(false), wantErr: nil},
+		{name: "autofitter increase-x-height", lib: face.l, module: ModuleAutofitter, p: &increaseXHeight, want: &IncreaseXHeight{Face: face.Face, Limit: 0}, wantErr: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.lib.Property(tt.module, tt.p); err != tt.wantErr {
+				t.Errorf("Library.Property() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(tt.p, tt.want); diff != nil {
+				t.Errorf("Library.Property() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestLibrary_SetProperty(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	tests := []struct {
+		name    string
+		lib     *Library
+		module  Module
+		p       Property
+		get     Property
+		wantErr error
+	}{
+		{name: "nil lib", lib: nil, module: ModuleTrueType, p: InterpreterVersion35, wantErr: ErrInvalidLibraryHandle},
+		{name: "nil", lib: face.l, module: ModuleTrueType, p: nil, wantErr: ErrInvalidArgument},
+		{name: "unknown module", lib: face.l, module: "foo", p: Warping(true), wantErr: ErrMissingModule},
+		{name: "unknown property", lib: face.l, module: ModuleTrueType, p: Warping(true), wantErr: ErrMissingProperty},
+		{name: "invalid value", lib: face.l, module: ModuleTrueType, p: InterpreterVersion(1), wantErr: ErrUnimplementedFeature},
+		{name: "invalid darkening-parameters", lib: face.l, module: ModuleCFF, p: DarkeningParameters{500, 400, 400, 275, 1667, 275, 2333, 0}, wantErr: ErrInvalidArgument},
+		{name: "increase-x-height nil face", lib: face.l, module: ModuleAutofitter, p: IncreaseXHeight{}, wantErr: ErrInvalidFaceHandle},
+
+		{name: "truetype interpreter-version", lib: face.l, module: ModuleTrueType, p: InterpreterVersion35, get: new(InterpreterVersion), wantErr: nil},
+		{name: "cff hinting-engine freetype", lib: face.l, module: ModuleCFF, p: HintingFreeType, wantErr: ErrUnimplementedFeature},
+		{name: "cff hinting-engine", lib: face.l, module: ModuleCFF, p: HintingAdobe, get: new(HintingEngine), wantErr: nil},
+		{name: "type1 hinting-engine", lib: face.l, module: ModuleType1, p: HintingAdobe, get: new(HintingEngine), wantErr: nil},
+		{name: "t1cid hinting-engine", lib: face.l, module: ModuleT1CID, p: HintingAdobe, get: new(HintingEngine), wantErr: nil},
+		{name: "cff no-stem-darkening", lib: face.l, module: ModuleCFF, p: NoStemDarkening(false), get: new(NoStemDarkening), wantErr: nil},
+		{name: "cff darkening-parameters", lib: face.l, module: ModuleCFF, p: DarkeningParameters{500, 300, 1000, 200, 1500, 100, 2000, 0}, get: new(DarkeningParameters), wantErr: nil},
+		{name: "autofitter default-script", lib: face.l, module: ModuleAutofitter, p: DefaultScript(AutohinterScriptCyrillic), get: new(DefaultScript), wantErr: nil},
+		{name: "autofitter fallback-script", lib: face.l, module: ModuleAutofitter, p: FallbackScript(AutohinterScriptNone), get: new(FallbackScript), wantErr: nil},
+		{name: "autofitter warping", lib: face.l, module: ModuleAutofitter, p: Warping(true), get: new(Warping), wantErr: nil},
+		{name: "autofitter increase-x-height", lib: face.l, module: ModuleAutofitter, p: IncreaseXHeight{Face: face.Face, Limit: 14}, get: &IncreaseXHeight{Face: face.Face}, wantErr: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.lib.SetProperty(tt.module, tt.p); err != tt.wantErr {
+				t.Errorf("Library.SetProperty() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.get == nil {
+				return
+			}
+
+			if err := tt.lib.Property(tt.module, tt.get); err != nil {
+				t.Fatalf("unable to get property: %v", err)
+			}
+			if diff := diff(tt.get, ptrTo(tt.p)); diff != nil {
+				t.Errorf("Library.SetProperty() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestParseProperties(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    []ModuleProperty
+		wantErr error
+	}{
+		{name: "empty", s: "", want: nil, wantErr: nil},
+		{name: "whitespace", s: " \t\n ", want: nil, wantErr: nil},
+		{
+			name: "all",
+			s:    "truetype:interpreter-version=35  cff:hinting-engine=freetype\ttype1:hinting-engine=adobe cff:no-stem-darkening=0 autofitter:no-stem-darkening=1 cff:darkening-parameters=500,300,1000,200,1500,100,2000,0 autofitter:default-script=cyrl autofitter:fallback-script=none autofitter:warping=1",
+			want: []ModuleProperty{
+				{Module: ModuleTrueType, Property: InterpreterVersion35},
+				{Module: ModuleCFF, Property: HintingFreeType},
+				{Module: ModuleType1, Property: HintingAdobe},
+				{Module: ModuleCFF, Property: NoStemDarkening(false)},
+				{Module: ModuleAutofitter, Property: NoStemDarkening(true)},
+				{Module: ModuleCFF, Property: DarkeningParameters{500, 300, 1000, 200, 1500, 100, 2000, 0}},
+				{Module: ModuleAutofitter, Property: DefaultScript(AutohinterScriptCyrillic)},
+				{Module: ModuleAutofitter, Property: FallbackScript(AutohinterScriptNone)},
+				{Module: ModuleAutofitter, Property: Warping(true)},
+			},
+			wantErr: nil,
+		},
+		{name: "unknown module", s: "foo:warping=1", want: []ModuleProperty{{Module: "foo", Property: Warping(true)}}, wantErr: nil},
+		{name: "unknown property", s: "truetype:foo=1", want: nil, wantErr: ErrMissingProperty},
+		{name: "increase-x-height", s: "autofitter:increase-x-height=14", want: nil, wantErr: ErrMissingProperty},
+		{name: "missing module", s: ":warping=1", want: nil, wantErr: ErrInvalidArgument},
+		{name: "missing colon", s: "warping=1", want: nil, wantErr: ErrInvalidArgument},
+		{name: "missing property", s: "autofitter:=1", want: nil, wantErr: ErrInvalidArgument},
+		{name: "missing equals", s: "autofitter:warping", want: nil, wantErr: ErrInvalidArgument},
+		{name: "missing value", s: "autofitter:warping=", want: nil, wantErr: ErrInvalidArgument},
+		{name: "bad interpreter-version", s: "truetype:interpreter-version=x", want: nil, wantErr: ErrInvalidArgument},
+		{name: "bad hinting-engine", s: "cff:hinting-engine=Adobe", want: nil, wantErr: ErrInvalidArgument},
+		{name: "bad no-stem-darkening", s: "cff:no-stem-darkening=true", want: nil, wantErr: ErrInvalidArgument},
+		{name: "bad darkening-parameters count", s: "cff:darkening-parameters=1,2,3", want: nil, wantErr: ErrInvalidArgument},
+		{name: "bad darkening-parameters value", s: "cff:darkening-parameters=1,2,3,4,5,6,7,x", want: nil, wantErr: ErrInvalidArgument},
+		{name: "bad default-script", s: "autofitter:default-script=latin", want: nil, wantErr: ErrInvalidArgument},
+		{name: "bad fallback-script", s: "autofitter:fallback-script=1", want: nil, wantErr: ErrInvalidArgument},
+		{name: "bad warping", s: "autofitter:warping=on", want: nil, wantErr: ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProperties(tt.s)
+			if err != tt.wantErr {
+				t.Errorf("ParseProperties() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("ParseProperties() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestInterpreterVersion_String(t *testing.T) {
+	tests := []struct {
+		name string
+		v    InterpreterVersion
+		want string
+	}{
+		{name: "35", v: InterpreterVersion35, want: "35"},
+		{name: "38", v: InterpreterVersion38, want: "38"},
+		{name: "40", v: InterpreterVersion40, want: "40"},
+		{name: "Unknown", v: 901929, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.String(); got != tt.want {
+				t.Errorf("InterpreterVersion.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHintingEngine_String(t *testing.T) {
+	tests := []struct {
+		name string
+		v    HintingEngine
+		want string
+	}{
+		{name: "FreeType", v: HintingFreeType, want: "FreeType"},
+		{name: "Adobe", v: HintingAdobe, want: "Adobe"},
+		{name: "Unknown", v: 901929, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.String(); got != tt.want {
+				t.Errorf("HintingEngine.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutohinterScript_String(t *testing.T) {
+	tests := []struct {
+		name string
+		v    AutohinterScript
+		want string
+	}{
+		{name: "Adlam", v: AutohinterScriptAdlam, want: "adlm"},
+		{name: "Lao", v: AutohinterScriptLao, want: "lao"},
+		{name: "Latin", v: AutohinterScriptLatin, want: "latn"},
+		{name: "None", v: AutohinterScriptNone, want: "none"},
+		{name: "CJK", v: AutohinterScriptCJK, want: "hani"},
+		{name: "DefaultScript", v: AutohinterScript(DefaultScript(AutohinterScriptGreek)), want: "grek"},
+		{name: "Unknown", v: 901929, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.String(); got != tt.want {
+				t.Errorf("AutohinterScript.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ptrInterpreterVersion(v InterpreterVersion) *InterpreterVersion { return &v }
+func ptrHintingEngine(v HintingEngine) *HintingEngine                { return &v }
+func ptrNoStemDarkening(v NoStemDarkening) *NoStemDarkening          { return &v }
+func ptrDefaultScript(v DefaultScript) *DefaultScript                { return &v }
+func ptrFallbackScript(v FallbackScript) *FallbackScript             { return &v }
+func ptrWarping(v Warping) *Warping                                  { return &v }
+
+func ptrTo(p Property) Property {
+	switch v := p.(type) {
+	case InterpreterVersion:
+		return &v
+	case HintingEngine:
+		return &v
+	case NoStemDarkening:
+		return &v
+	case DarkeningParameters:
+		return &v
+	case DefaultScript:
+		return &v
+	case FallbackScript:
+		return &v
+	case Warping:
+		return &v
+	case IncreaseXHeight:
+		return &v
+	default:
+		return p
+	}
+}
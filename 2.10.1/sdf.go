@@ -0,0 +1,424 @@
+package freetype2
+
+import (
+	"image"
+	"math"
+	"sort"
+)
+
+// SDFOptions controls the generation of signed distance fields.
+type SDFOptions struct {
+	// The largest distance encoded in the field, in output pixels. The image
+	// is padded by as many pixels on every side. Defaults to 8.
+	Spread float64
+	// The number of output pixels per outline pixel, so that a glyph loaded at
+	// a large size can produce a smaller field. Defaults to 1.
+	Scale float64
+}
+
+// SDF is a signed distance field, as generated by Outline.SDF and
+// GlyphSlot.SDF.
+type SDF struct {
+	// The distance to the outline at the center of every pixel. 128 lies on
+	// the outline, higher values are inside, and 0 or 255 are Spread pixels
+	// away or more.
+	Image *image.Gray
+	// The spread used to encode distances, in output pixels.
+	Spread float64
+	// The image's left bearing expressed in integer output pixels, including
+	// the padding.
+	BitmapLeft int
+	// The image's top bearing expressed in integer output pixels, including
+	// the padding. This is the distance from the baseline to the top-most
+	// row, upwards y coordinates being positive.
+	BitmapTop int
+	// The metrics of the glyph, see GlyphSlot.Metrics. They are not scaled,
+	// and are only set by GlyphSlot.SDF.
+	Metrics GlyphMetrics
+	// The transformed advance, see GlyphSlot.Advance. It is not scaled, and
+	// is only set by GlyphSlot.SDF.
+	Advance Vector26_6
+}
+
+// SDF generates a signed distance field of the outline, an 8-bit image that
+// can be sampled with linear filtering and thresholded at 128 to draw the
+// outline at any size.
+//
+// The distance is measured to the boundary of the filled area, following the
+// fill rule of the outline, so overlapping contours, as found in variable
+// fonts, don't produce artifacts where they cross. Curves are flattened to a
+// tenth of an output pixel.
+//
+// It returns ErrInvalidArgument if opts holds negative values.
+func (o *Outline) SDF(opts SDFOptions) (*SDF, error) {
+	if o == nil || o.ptr == nil {
+		return nil, ErrInvalidOutline
+	}
+
+	if opts.Spread < 0 || opts.Scale < 0 || math.IsNaN(opts.Spread) || math.IsNaN(opts.Scale) {
+		return nil, ErrInvalidArgument
+	}
+	if opts.Spread == 0 {
+		opts.Spread = 8
+	}
+	if opts.Scale == 0 {
+		opts.Scale = 1
+	}
+
+	f := &sdfFlattener{scale: opts.Scale / 64, tolerance: 0.1}
+	if err := o.Decompose(f, 0, 0); err != nil {
+		return nil, err
+	}
+
+	ret := &SDF{Image: &image.Gray{}, Spread: opts.Spread}
+	if len(f.segments) == 0 {
+		return ret, nil
+	}
+
+	evenOdd := o.Flags&OutlineEvenOddFill == OutlineEvenOddFill
+	bands := newSDFBands(f.segments)
+	boundary := sdfBoundary(f.segments, bands, evenOdd)
+
+	// distances beyond the spread are clamped, so only the segments within
+	// the spread of a pixel are measured.
+	grid := newSDFGrid(boundary, math.Max(opts.Spread, 4), opts.Spread)
+
+	xMin, yMin, xMax, yMax := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, s := range f.segments {
+		xMin = math.Min(xMin, math.Min(s.a.x, s.b.x))
+		yMin = math.Min(yMin, math.Min(s.a.y, s.b.y))
+		xMax = math.Max(xMax, math.Max(s.a.x, s.b.x))
+		yMax = math.Max(yMax, math.Max(s.a.y, s.b.y))
+	}
+
+	pad := int(math.Ceil(opts.Spread))
+	left := int(math.Floor(xMin)) - pad
+	top := int(math.Ceil(yMax)) + pad
+	width := int(math.Ceil(xMax)) + pad - left
+	height := top - (int(math.Floor(yMin)) - pad)
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			p := sdfPoint{float64(left+x) + 0.5, float64(top-y) - 0.5}
+
+			d := opts.Spread
+			for _, i := range grid.cell(p) {
+				d = math.Min(d, boundary[i].distance(p))
+			}
+			if !bands.inside(p, evenOdd) {
+				d = -d
+			}
+
+			v := 128 + d*128/opts.Spread
+			img.Pix[img.PixOffset(x, y)] = uint8(math.Max(0, math.Min(255, math.Round(v))))
+		}
+	}
+
+	ret.Image = img
+	ret.BitmapLeft = left
+	ret.BitmapTop = top
+	return ret, nil
+}
+
+// SDF generates a signed distance field of the outline of the glyph loaded in
+// the slot, see Outline.SDF. The glyph must not be rendered, so LoadRender
+// should not be used.
+//
+// It returns ErrInvalidGlyphFormat if the glyph is not an outline.
+func (s *GlyphSlot) SDF(opts SDFOptions) (*SDF, error) {
+	if s == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	if s.Format != GlyphFormatOutline {
+		return nil, ErrInvalidGlyphFormat
+	}
+
+	ret, err := s.Outline.SDF(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ret.Metrics = s.Metrics
+	ret.Advance = s.Advance
+	return ret, nil
+}
+
+type sdfPoint struct {
+	x, y float64
+}
+
+func (p sdfPoint) sub(q sdfPoint) sdfPoint             { return sdfPoint{p.x - q.x, p.y - q.y} }
+func (p sdfPoint) add(q sdfPoint) sdfPoint             { return sdfPoint{p.x + q.x, p.y + q.y} }
+func (p sdfPoint) mul(k float64) sdfPoint              { return sdfPoint{p.x * k, p.y * k} }
+func (p sdfPoint) dot(q sdfPoint) float64              { return p.x*q.x + p.y*q.y }
+func (p sdfPoint) cross(q sdfPoint) float64            { return p.x*q.y - p.y*q.x }
+func (p sdfPoint) lerp(q sdfPoint, t float64) sdfPoint { return p.add(q.sub(p).mul(t)) }
+
+type sdfSegment struct {
+	a, b sdfPoint
+}
+
+func (s sdfSegment) distance(p sdfPoint) float64 {
+	ab, ap := s.b.sub(s.a), p.sub(s.a)
+	t := 0.0
+	if l := ab.dot(ab); l > 0 {
+		t = math.Max(0, math.Min(1, ap.dot(ab)/l))
+	}
+	d := ap.sub(ab.mul(t))
+	return math.Sqrt(d.dot(d))
+}
+
+// sdfFlattener is an OutlineDecomposer that converts the outline to line
+// segments, in output pixels.
+type sdfFlattener struct {
+	scale     float64
+	tolerance float64
+	pen       sdfPoint
+	segments  []sdfSegment
+}
+
+func (f *sdfFlattener) point(v Vector) sdfPoint {
+	return sdfPoint{float64(v.X) * f.scale, float64(v.Y) * f.scale}
+}
+
+func (f *sdfFlattener) lineTo(to sdfPoint) {
+	if to != f.pen {
+		f.segments = append(f.segments, sdfSegment{f.pen, to})
+	}
+	f.pen = to
+}
+
+func (f *sdfFlattener) MoveTo(to Vector) error {
+	f.pen = f.point(to)
+	return nil
+}
+
+func (f *sdfFlattener) LineTo(to Vector) error {
+	f.lineTo(f.point(to))
+	return nil
+}
+
+func (f *sdfFlattener) ConicTo(control, to Vector) error {
+	p0, p1, p2 := f.pen, f.point(control), f.point(to)
+
+	dd := p0.sub(p1.mul(2)).add(p2)
+	n := f.steps(math.Sqrt(dd.dot(dd)) / 4)
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		f.lineTo(p0.lerp(p1, t).lerp(p1.lerp(p2, t), t))
+	}
+	return nil
+}
+
+func (f *sdfFlattener) CubicTo(control1, control2, to Vector) error {
+	p0, p1, p2, p3 := f.pen, f.point(control1), f.point(control2), f.point(to)
+
+	dd1 := p0.sub(p1.mul(2)).add(p2)
+	dd2 := p1.sub(p2.mul(2)).add(p3)
+	n := f.steps(math.Sqrt(math.Max(dd1.dot(dd1), dd2.dot(dd2))) * 3 / 4)
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		a, b, c := p0.lerp(p1, t), p1.lerp(p2, t), p2.lerp(p3, t)
+		f.lineTo(a.lerp(b, t).lerp(b.lerp(c, t), t))
+	}
+	return nil
+}
+
+// steps returns the number of segments needed to flatten a curve whose
+// deviation from a line is at most dev, as the deviation of each segment
+// decreases with the square of their number.
+func (f *sdfFlattener) steps(dev float64) int {
+	n := int(math.Ceil(math.Sqrt(dev / f.tolerance)))
+	if n < 1 {
+		return 1
+	}
+	if n > 100 {
+		return 100
+	}
+	return n
+}
+
+// sdfBands buckets the segments of the outline by horizontal bands one pixel
+// high, so that the winding number around a point only looks at the segments
+// crossing its band.
+type sdfBands struct {
+	y        float64 // the bottom of the first band
+	bands    [][]int32
+	segments []sdfSegment
+}
+
+func newSDFBands(segments []sdfSegment) *sdfBands {
+	b := &sdfBands{segments: segments}
+	if len(segments) == 0 {
+		return b
+	}
+
+	yMin, yMax := math.Inf(1), math.Inf(-1)
+	for _, s := range segments {
+		yMin = math.Min(yMin, math.Min(s.a.y, s.b.y))
+		yMax = math.Max(yMax, math.Max(s.a.y, s.b.y))
+	}
+	b.y = math.Floor(yMin)
+	b.bands = make([][]int32, int(yMax-b.y)+1)
+	for i, s := range segments {
+		lo := int(math.Min(s.a.y, s.b.y) - b.y)
+		hi := int(math.Max(s.a.y, s.b.y) - b.y)
+		for k := lo; k <= hi; k++ {
+			b.bands[k] = append(b.bands[k], int32(i))
+		}
+	}
+	return b
+}
+
+// winding returns the winding number of the closed polylines around p.
+func (b *sdfBands) winding(p sdfPoint) int {
+	k := int(math.Floor(p.y - b.y))
+	if k < 0 || k >= len(b.bands) {
+		return 0
+	}
+
+	w := 0
+	for _, i := range b.bands[k] {
+		s := b.segments[i]
+		if s.a.y <= p.y {
+			if s.b.y > p.y && s.b.sub(s.a).cross(p.sub(s.a)) > 0 {
+				w++
+			}
+		} else if s.b.y <= p.y && s.b.sub(s.a).cross(p.sub(s.a)) < 0 {
+			w--
+		}
+	}
+	return w
+}
+
+func (b *sdfBands) inside(p sdfPoint, evenOdd bool) bool {
+	w := b.winding(p)
+	if evenOdd {
+		return w%2 != 0
+	}
+	return w != 0
+}
+
+// sdfGrid buckets segments by the cells of a uniform grid overlapped by their
+// bounding boxes, grown by a margin, so that the segments within the margin of
+// a point, or crossing another segment, are found without scanning them all.
+type sdfGrid struct {
+	x, y   float64 // the bottom left corner of the grid
+	size   float64 // the size of the cells
+	margin float64
+	w, h   int
+	cells  [][]int32
+}
+
+func newSDFGrid(segments []sdfSegment, size, margin float64) *sdfGrid {
+	g := &sdfGrid{size: size, margin: margin}
+	if len(segments) == 0 {
+		return g
+	}
+
+	xMin, yMin, xMax, yMax := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, s := range segments {
+		xMin = math.Min(xMin, math.Min(s.a.x, s.b.x))
+		yMin = math.Min(yMin, math.Min(s.a.y, s.b.y))
+		xMax = math.Max(xMax, math.Max(s.a.x, s.b.x))
+		yMax = math.Max(yMax, math.Max(s.a.y, s.b.y))
+	}
+	g.x, g.y = xMin-margin, yMin-margin
+	g.w = int((xMax+margin-g.x)/size) + 1
+	g.h = int((yMax+margin-g.y)/size) + 1
+	g.cells = make([][]int32, g.w*g.h)
+
+	for i, s := range segments {
+		x0, y0, x1, y1 := g.span(s)
+		for cy := y0; cy <= y1; cy++ {
+			for cx := x0; cx <= x1; cx++ {
+				g.cells[cy*g.w+cx] = append(g.cells[cy*g.w+cx], int32(i))
+			}
+		}
+	}
+	return g
+}
+
+// span returns the range of cells overlapped by the grown bounding box of s.
+func (g *sdfGrid) span(s sdfSegment) (x0, y0, x1, y1 int) {
+	x0 = int((math.Min(s.a.x, s.b.x) - g.margin - g.x) / g.size)
+	y0 = int((math.Min(s.a.y, s.b.y) - g.margin - g.y) / g.size)
+	x1 = int((math.Max(s.a.x, s.b.x) + g.margin - g.x) / g.size)
+	y1 = int((math.Max(s.a.y, s.b.y) + g.margin - g.y) / g.size)
+	return x0, y0, x1, y1
+}
+
+// cell returns the segments whose grown bounding box may contain p.
+func (g *sdfGrid) cell(p sdfPoint) []int32 {
+	x, y := (p.x-g.x)/g.size, (p.y-g.y)/g.size
+	if x < 0 || y < 0 || x >= float64(g.w) || y >= float64(g.h) {
+		return nil
+	}
+	return g.cells[int(y)*g.w+int(x)]
+}
+
+// sdfBoundary returns the parts of the segments that separate filled and
+// empty areas, dropping those inside the filled area, where contours overlap.
+func sdfBoundary(segments []sdfSegment, bands *sdfBands, evenOdd bool) []sdfSegment {
+	const eps = 1e-3
+
+	// segments can only cross the ones sharing a cell, the grid has about as
+	// many cells as there are segments.
+	xMin, yMin, xMax, yMax := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, s := range segments {
+		xMin = math.Min(xMin, math.Min(s.a.x, s.b.x))
+		yMin = math.Min(yMin, math.Min(s.a.y, s.b.y))
+		xMax = math.Max(xMax, math.Max(s.a.x, s.b.x))
+		yMax = math.Max(yMax, math.Max(s.a.y, s.b.y))
+	}
+	size := math.Max(xMax-xMin, yMax-yMin) / math.Sqrt(float64(len(segments)))
+	grid := newSDFGrid(segments, math.Max(size, 0.5), 0)
+	seen := make([]int, len(segments))
+
+	var ret []sdfSegment
+	for i, s := range segments {
+		r := s.b.sub(s.a)
+		ts := []float64{0, 1}
+
+		x0, y0, x1, y1 := grid.span(s)
+		for cy := y0; cy <= y1; cy++ {
+			for cx := x0; cx <= x1; cx++ {
+				for _, j := range grid.cells[cy*grid.w+cx] {
+					if int(j) == i || seen[j] == i+1 {
+						continue
+					}
+					seen[j] = i + 1
+
+					o := segments[j]
+					q := o.b.sub(o.a)
+					d := r.cross(q)
+					if d == 0 {
+						continue
+					}
+					t := o.a.sub(s.a).cross(q) / d
+					u := o.a.sub(s.a).cross(r) / d
+					if t > 0 && t < 1 && u >= 0 && u <= 1 {
+						ts = append(ts, t)
+					}
+				}
+			}
+		}
+		sort.Float64s(ts)
+
+		l := math.Sqrt(r.dot(r))
+		normal := sdfPoint{-r.y / l, r.x / l}.mul(eps)
+		for k := 1; k < len(ts); k++ {
+			if ts[k]-ts[k-1] <= 0 {
+				continue
+			}
+			piece := sdfSegment{s.a.lerp(s.b, ts[k-1]), s.a.lerp(s.b, ts[k])}
+			mid := piece.a.lerp(piece.b, 0.5)
+			if bands.inside(mid.add(normal), evenOdd) != bands.inside(mid.sub(normal), evenOdd) {
+				ret = append(ret, piece)
+			}
+		}
+	}
+	return ret
+}
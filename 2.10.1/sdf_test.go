@@ -0,0 +1,252 @@
+package freetype2
+
+import (
+	"math"
+	"testing"
+
+	"github.com/flga/freetype2/fixed"
+)
+
+func TestOutline_SDF(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to create lib: %v", err)
+	}
+	defer l.Free()
+
+	var nilOutline *Outline
+	if _, err := nilOutline.SDF(SDFOptions{}); err != ErrInvalidOutline {
+		t.Errorf("Outline.SDF() error = %v, want %v", err, ErrInvalidOutline)
+	}
+
+	rect := func(b *OutlineBuilder, x0, y0, x1, y1 Pos) {
+		b.MoveTo(Vector{X: x0 << 6, Y: y0 << 6})
+		b.LineTo(Vector{X: x0 << 6, Y: y1 << 6})
+		b.LineTo(Vector{X: x1 << 6, Y: y1 << 6})
+		b.LineTo(Vector{X: x1 << 6, Y: y0 << 6})
+		b.Close()
+	}
+
+	// boxDistance is the signed distance from p to the box.
+	boxDistance := func(x0, y0, x1, y1 float64) func(x, y float64) float64 {
+		return func(x, y float64) float64 {
+			dx := math.Max(x0-x, x-x1)
+			dy := math.Max(y0-y, y-y1)
+			if dx <= 0 && dy <= 0 {
+				return -math.Max(dx, dy)
+			}
+			return -math.Hypot(math.Max(dx, 0), math.Max(dy, 0))
+		}
+	}
+
+	tests := []struct {
+		name     string
+		build    func(b *OutlineBuilder)
+		opts     SDFOptions
+		wantErr  error
+		wantRect [4]int // left, top, width, height
+		distance func(x, y float64) float64
+	}{
+		{
+			name:    "negative spread",
+			build:   func(b *OutlineBuilder) { rect(b, 0, 0, 4, 4) },
+			opts:    SDFOptions{Spread: -1},
+			wantErr: ErrInvalidArgument,
+		},
+		{
+			name:    "negative scale",
+			build:   func(b *OutlineBuilder) { rect(b, 0, 0, 4, 4) },
+			opts:    SDFOptions{Scale: -1},
+			wantErr: ErrInvalidArgument,
+		},
+		{
+			name:  "empty",
+			build: func(b *OutlineBuilder) {},
+		},
+		{
+			name:     "square",
+			build:    func(b *OutlineBuilder) { rect(b, 0, 0, 4, 4) },
+			opts:     SDFOptions{Spread: 2},
+			wantRect: [4]int{-2, 6, 8, 8},
+			distance: boxDistance(0, 0, 4, 4),
+		},
+		{
+			name:     "scaled",
+			build:    func(b *OutlineBuilder) { rect(b, 0, 0, 8, 4) },
+			opts:     SDFOptions{Spread: 2, Scale: 0.5},
+			wantRect: [4]int{-2, 4, 8, 6},
+			distance: boxDistance(0, 0, 4, 2),
+		},
+		{
+			name: "overlapping",
+			build: func(b *OutlineBuilder) {
+				rect(b, 0, 0, 4, 4)
+				rect(b, 2, 0, 8, 4)
+			},
+			opts:     SDFOptions{Spread: 4},
+			wantRect: [4]int{-4, 8, 16, 12},
+			distance: boxDistance(0, 0, 8, 4),
+		},
+		{
+			name: "even-odd",
+			build: func(b *OutlineBuilder) {
+				b.SetFlags(OutlineEvenOddFill)
+				rect(b, 0, 0, 4, 4)
+				rect(b, 1, 1, 3, 3)
+			},
+			opts:     SDFOptions{Spread: 4},
+			wantRect: [4]int{-4, 8, 12, 12},
+			distance: func(x, y float64) float64 {
+				outer, inner := boxDistance(0, 0, 4, 4)(x, y), boxDistance(1, 1, 3, 3)(x, y)
+				return math.Min(outer, -inner)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b OutlineBuilder
+			tt.build(&b)
+			o, err := b.Outline(l)
+			if err != nil {
+				t.Fatalf("unable to build outline: %v", err)
+			}
+			defer o.Free()
+
+			got, err := o.SDF(tt.opts)
+			if err != tt.wantErr {
+				t.Fatalf("Outline.SDF() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			bounds := got.Image.Bounds()
+			if gotRect := [4]int{got.BitmapLeft, got.BitmapTop, bounds.Dx(), bounds.Dy()}; gotRect != tt.wantRect {
+				t.Fatalf("Outline.SDF() left, top, width, height = %v, want %v", gotRect, tt.wantRect)
+			}
+			if tt.distance == nil {
+				return
+			}
+
+			for y := 0; y < bounds.Dy(); y++ {
+				for x := 0; x < bounds.Dx(); x++ {
+					d := tt.distance(float64(got.BitmapLeft+x)+0.5, float64(got.BitmapTop-y)-0.5)
+					want := math.Max(0, math.Min(255, math.Round(128+d*128/got.Spread)))
+					if v := got.Image.GrayAt(x, y).Y; float64(v) != want {
+						t.Errorf("Outline.SDF() at (%d, %d) = %d, want %v", x, y, v, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGlyphSlot_SDF(t *testing.T) {
+	var nilSlot *GlyphSlot
+	if _, err := nilSlot.SDF(SDFOptions{}); err != ErrInvalidArgument {
+		t.Errorf("GlyphSlot.SDF() error = %v, want %v", err, ErrInvalidArgument)
+	}
+
+	// Decovar is made of overlapping contours, measuring the distance to
+	// every contour leaves lines of zero distance inside the glyphs.
+	decovar := faceFromPath("variable/Decovar/DecovarAlpha-VF.ttf")
+	decovarWorm := func() (testface, error) {
+		face, err := decovar()
+		if err != nil {
+			return face, err
+		}
+		coords := make([]fixed.Int16_16, 15)
+		coords[9] = 1000 << 16 // WMX2
+		return face, face.SetVarDesignCoords(coords)
+	}
+
+	tests := []struct {
+		name string
+		face func() (testface, error)
+		char rune
+	}{
+		{name: "Go Regular O", face: goRegular, char: 'O'},
+		{name: "Go Regular g", face: goRegular, char: 'g'},
+		{name: "Decovar A", face: decovar, char: 'A'},
+		{name: "Decovar S", face: decovar, char: 'S'},
+		{name: "Decovar worm A", face: decovarWorm, char: 'A'},
+		{name: "Decovar worm g", face: decovarWorm, char: 'g'},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to open font: %v", err)
+			}
+			defer face.Free()
+
+			if err := face.SetPixelSizes(0, 48); err != nil {
+				t.Fatalf("unable to set size: %v", err)
+			}
+
+			if err := face.LoadChar(tt.char, LoadNoHinting|LoadNoBitmap); err != nil {
+				t.Fatalf("unable to load glyph: %v", err)
+			}
+			slot := face.GlyphSlot()
+			got, err := slot.SDF(SDFOptions{})
+			if err != nil {
+				t.Fatalf("GlyphSlot.SDF() error = %v", err)
+			}
+			if got.Metrics != slot.Metrics || got.Advance != slot.Advance {
+				t.Errorf("GlyphSlot.SDF() metrics = %v, %v, want %v, %v", got.Metrics, got.Advance, slot.Metrics, slot.Advance)
+			}
+
+			// the field must agree with the rasterizer: fully covered pixels
+			// are inside, and empty pixels are outside.
+			if err := slot.RenderGlyph(RenderModeNormal); err != nil {
+				t.Fatalf("unable to render glyph: %v", err)
+			}
+			if _, err := slot.SDF(SDFOptions{}); err != ErrInvalidGlyphFormat {
+				t.Errorf("GlyphSlot.SDF() error = %v, want %v", err, ErrInvalidGlyphFormat)
+			}
+
+			bmp := slot.Bitmap
+			bounds := got.Image.Bounds()
+			for y := 0; y < bounds.Dy(); y++ {
+				for x := 0; x < bounds.Dx(); x++ {
+					row, col := slot.BitmapTop-got.BitmapTop+y, got.BitmapLeft+x-slot.BitmapLeft
+					coverage := byte(0)
+					if row >= 0 && row < bmp.Rows && col >= 0 && col < bmp.Width {
+						coverage = bmp.Buffer[row*bmp.Pitch+col]
+					}
+
+					v := got.Image.GrayAt(x, y).Y
+					if coverage == 0xff && v <= 128 || coverage == 0 && v >= 128 {
+						t.Errorf("GlyphSlot.SDF() at (%d, %d) = %d, coverage = %d", x, y, v, coverage)
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkOutline_SDF(b *testing.B) {
+	b.StopTimer()
+
+	face, err := goRegular()
+	if err != nil {
+		b.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(256<<6, 256<<6, 72, 72); err != nil {
+		b.Fatalf("unable to set char size: %v", err)
+	}
+	if err := face.LoadChar('@', LoadDefault); err != nil {
+		b.Fatalf("unable to load char: %v", err)
+	}
+	outline := face.GlyphSlot().Outline
+
+	b.ReportAllocs()
+	b.StartTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := outline.SDF(SDFOptions{}); err != nil {
+			b.Fatalf("Outline.SDF() error = %v", err)
+		}
+	}
+}
@@ -0,0 +1,337 @@
+// +build harfbuzz
+
+package freetype2
+
+// #cgo !static pkg-config: harfbuzz
+// #cgo static CFLAGS: -DFREETYPE2_HARFBUZZ_STATIC
+//
+// #include <stdlib.h>
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #ifdef FREETYPE2_HARFBUZZ_STATIC
+// #include "harfbuzz.h"
+// #else
+// #include <hb.h>
+// #include <hb-ft.h>
+// #endif
+import "C"
+
+import (
+	"strings"
+	"unsafe"
+)
+
+// Direction defines the direction of a text segment.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-common.html#hb-direction-t
+type Direction int
+
+const (
+	// DirectionInvalid is the initial, unset direction. Shape guesses it from
+	// the script when it is used.
+	DirectionInvalid Direction = C.HB_DIRECTION_INVALID
+	// DirectionLTR is used for text displayed from left to right.
+	DirectionLTR Direction = C.HB_DIRECTION_LTR
+	// DirectionRTL is used for text displayed from right to left.
+	DirectionRTL Direction = C.HB_DIRECTION_RTL
+	// DirectionTTB is used for text displayed from top to bottom.
+	DirectionTTB Direction = C.HB_DIRECTION_TTB
+	// DirectionBTT is used for text displayed from bottom to top.
+	DirectionBTT Direction = C.HB_DIRECTION_BTT
+)
+
+func (d Direction) String() string {
+	switch d {
+	case DirectionInvalid:
+		return "Invalid"
+	case DirectionLTR:
+		return "LTR"
+	case DirectionRTL:
+		return "RTL"
+	case DirectionTTB:
+		return "TTB"
+	case DirectionBTT:
+		return "BTT"
+	default:
+		return "Unknown"
+	}
+}
+
+// Feature holds information about a feature and its value to apply to a range
+// of the shaped text.
+//
+// Start and End are byte offsets into the text, End being exclusive. If both
+// are zero the feature applies to the whole text.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-common.html#hb-feature-t
+type Feature struct {
+	// The OpenType feature tag, like MakeTag("liga").
+	Tag Tag
+	// The value of the feature, 0 disables it, 1 enables it and other values
+	// select an alternate for features like ‘aalt’.
+	Value uint32
+	Start uint
+	End   uint
+}
+
+// ParseFeature parses a feature string using the same syntax as CSS
+// font-feature-settings and hb-shape, like "liga", "-kern", "aalt=2" or
+// "smcp[3:5]".
+//
+// It returns ErrInvalidArgument if s cannot be parsed.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-common.html#hb-feature-from-string
+func ParseFeature(s string) (Feature, error) {
+	cs := C.CString(s)
+	defer free(unsafe.Pointer(cs))
+
+	var feature C.hb_feature_t
+	if C.hb_feature_from_string(cs, C.int(len(s)), &feature) == 0 {
+		return Feature{}, ErrInvalidArgument
+	}
+
+	ret := Feature{
+		Tag:   Tag(feature.tag),
+		Value: uint32(feature.value),
+		Start: uint(feature.start),
+		End:   uint(feature.end),
+	}
+	if feature.end == C.HB_FEATURE_GLOBAL_END {
+		if feature.start == C.HB_FEATURE_GLOBAL_START {
+			ret.End = 0
+		} else {
+			ret.End = ^uint(0)
+		}
+	}
+	return ret, nil
+}
+
+// ParseFeatures parses a comma separated list of features, see ParseFeature.
+func ParseFeatures(s string) ([]Feature, error) {
+	var ret []Feature
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		feature, err := ParseFeature(part)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, feature)
+	}
+	return ret, nil
+}
+
+func (f Feature) String() string {
+	feature := f.cfeature()
+
+	var buf [128]C.char
+	C.hb_feature_to_string(&feature, &buf[0], C.uint(len(buf)))
+	return C.GoString(&buf[0])
+}
+
+func (f Feature) cfeature() C.hb_feature_t {
+	ret := C.hb_feature_t{
+		tag:   C.hb_tag_t(f.Tag),
+		value: C.uint32_t(f.Value),
+		start: C.uint(f.Start),
+		end:   C.uint(f.End),
+	}
+	if f.Start == 0 && f.End == 0 {
+		ret.start = C.HB_FEATURE_GLOBAL_START
+		ret.end = C.HB_FEATURE_GLOBAL_END
+	}
+	return ret
+}
+
+// ShapeOptions controls how text is shaped.
+type ShapeOptions struct {
+	// The text direction, if DirectionInvalid it is guessed from the script.
+	Direction Direction
+	// The ISO 15924 script tag, like "Latn", "Arab" or "Deva". If empty it
+	// is guessed from the text.
+	Script string
+	// The BCP 47 language tag, like "en", "ar" or "hi". If empty the process'
+	// locale is used.
+	Language string
+	// The OpenType features to enable or disable, on top of the defaults
+	// selected by the shaper for the script.
+	Features []Feature
+}
+
+// ShapedGlyph holds a shaped glyph and its position, in 26.6 pixels unless the
+// face has no size selected, in which case font units are used.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-glyph-position-t
+type ShapedGlyph struct {
+	// The glyph index in the face.
+	Index GlyphIndex
+	// The byte offset into the shaped text of the first character that
+	// produced this glyph. Glyphs produced from the same cluster, like the
+	// components of a ligature, share the same value.
+	Cluster int
+	// How much the line advances after drawing this glyph when setting text in
+	// horizontal direction.
+	XAdvance Pos
+	// How much the line advances after drawing this glyph when setting text in
+	// vertical direction.
+	YAdvance Pos
+	// How much the glyph moves on the X-axis before drawing it, this should
+	// not affect how much the line advances.
+	XOffset Pos
+	// How much the glyph moves on the Y-axis before drawing it, this should
+	// not affect how much the line advances.
+	YOffset Pos
+}
+
+// Shaper shapes text with HarfBuzz, using the face it was created from.
+//
+// It keeps a reference to the face, which is not fully released until the
+// shaper is freed. Note that the shaper will be freed, when destroying the face,
+// by Face.Free.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-ft.html#hb-ft-font-create-referenced
+type Shaper struct {
+	ptr  *C.hb_font_t
+	face *Face
+}
+
+// NewShaper creates a HarfBuzz font from the face.
+//
+// The shaper uses the face's current size, which is refreshed on every Shape
+// call, so that it keeps working after calls like Face.SetCharSize.
+func (f *Face) NewShaper() (*Shaper, error) {
+	if f == nil || f.ptr == nil {
+		return nil, ErrInvalidFaceHandle
+	}
+
+	font := C.hb_ft_font_create_referenced(f.ptr)
+	if font == nil || C.hb_font_get_empty() == font {
+		return nil, ErrOutOfMemory
+	}
+
+	ret := &Shaper{ptr: font, face: f}
+	f.dealloc = append(f.dealloc, func() { ret.Free() })
+
+	return ret, nil
+}
+
+// Free releases the HarfBuzz font and its reference to the face.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-destroy
+func (s *Shaper) Free() {
+	if s == nil || s.ptr == nil {
+		return
+	}
+
+	C.hb_font_destroy(s.ptr)
+	*s = Shaper{}
+}
+
+// SetLoadFlags sets the flags used when HarfBuzz loads glyphs to compute
+// advances and extents. The default is LoadDefault|LoadNoHinting.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-ft.html#hb-ft-font-set-load-flags
+func (s *Shaper) SetLoadFlags(flags LoadFlag) {
+	if s == nil || s.ptr == nil {
+		return
+	}
+
+	C.hb_ft_font_set_load_flags(s.ptr, C.int(flags))
+}
+
+// LoadFlags returns the flags used when HarfBuzz loads glyphs.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-ft.html#hb-ft-font-get-load-flags
+func (s *Shaper) LoadFlags() LoadFlag {
+	if s == nil || s.ptr == nil {
+		return 0
+	}
+
+	return LoadFlag(C.hb_ft_font_get_load_flags(s.ptr))
+}
+
+// Shape shapes UTF-8 text, returning the glyphs to draw in visual order.
+//
+// Text is shaped as a single run, callers are responsible for splitting it by
+// script and direction beforehand.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-shape.html#hb-shape
+func (s *Shaper) Shape(text string, opts ShapeOptions) ([]ShapedGlyph, error) {
+	if s == nil || s.ptr == nil || s.face == nil || s.face.ptr == nil {
+		return nil, ErrInvalidFaceHandle
+	}
+
+	if text == "" {
+		return nil, nil
+	}
+
+	buf := C.hb_buffer_create()
+	defer C.hb_buffer_destroy(buf)
+
+	ctext := C.CString(text)
+	defer free(unsafe.Pointer(ctext))
+
+	C.hb_buffer_add_utf8(buf, ctext, C.int(len(text)), 0, C.int(len(text)))
+	if C.hb_buffer_allocation_successful(buf) == 0 {
+		return nil, ErrOutOfMemory
+	}
+
+	if opts.Direction != DirectionInvalid {
+		C.hb_buffer_set_direction(buf, C.hb_direction_t(opts.Direction))
+	}
+	if opts.Script != "" {
+		cscript := C.CString(opts.Script)
+		defer free(unsafe.Pointer(cscript))
+		C.hb_buffer_set_script(buf, C.hb_script_from_string(cscript, -1))
+	}
+	if opts.Language != "" {
+		clang := C.CString(opts.Language)
+		defer free(unsafe.Pointer(clang))
+		C.hb_buffer_set_language(buf, C.hb_language_from_string(clang, -1))
+	}
+	C.hb_buffer_guess_segment_properties(buf)
+
+	var cfeatures *C.hb_feature_t
+	if len(opts.Features) > 0 {
+		cfeatures = (*C.hb_feature_t)(C.calloc(C.size_t(len(opts.Features)), C.sizeof_hb_feature_t))
+		defer free(unsafe.Pointer(cfeatures))
+
+		features := (*[(1<<31 - 1) / C.sizeof_hb_feature_t]C.hb_feature_t)(unsafe.Pointer(cfeatures))[:len(opts.Features):len(opts.Features)]
+		for i, f := range opts.Features {
+			features[i] = f.cfeature()
+		}
+	}
+
+	C.hb_ft_font_changed(s.ptr)
+	C.hb_shape(s.ptr, buf, cfeatures, C.uint(len(opts.Features)))
+	if C.hb_buffer_allocation_successful(buf) == 0 {
+		return nil, ErrOutOfMemory
+	}
+
+	var n C.uint
+	cinfos := C.hb_buffer_get_glyph_infos(buf, &n)
+	cpositions := C.hb_buffer_get_glyph_positions(buf, nil)
+	if n == 0 {
+		return nil, nil
+	}
+
+	infos := (*[(1<<31 - 1) / C.sizeof_hb_glyph_info_t]C.hb_glyph_info_t)(unsafe.Pointer(cinfos))[:n:n]
+	positions := (*[(1<<31 - 1) / C.sizeof_hb_glyph_position_t]C.hb_glyph_position_t)(unsafe.Pointer(cpositions))[:n:n]
+
+	ret := make([]ShapedGlyph, n)
+	for i := range ret {
+		ret[i] = ShapedGlyph{
+			Index:    GlyphIndex(infos[i].codepoint),
+			Cluster:  int(infos[i].cluster),
+			XAdvance: Pos(positions[i].x_advance),
+			YAdvance: Pos(positions[i].y_advance),
+			XOffset:  Pos(positions[i].x_offset),
+			YOffset:  Pos(positions[i].y_offset),
+		}
+	}
+
+	return ret, nil
+}
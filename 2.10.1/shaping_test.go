@@ -0,0 +1,189 @@
+// +build harfbuzz
+
+package freetype2
+
+import (
+	"testing"
+)
+
+func TestDirection_String(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Direction
+		want string
+	}{
+		{name: "Invalid", d: DirectionInvalid, want: "Invalid"},
+		{name: "LTR", d: DirectionLTR, want: "LTR"},
+		{name: "RTL", d: DirectionRTL, want: "RTL"},
+		{name: "TTB", d: DirectionTTB, want: "TTB"},
+		{name: "BTT", d: DirectionBTT, want: "BTT"},
+		{name: "Unknown", d: 901929, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.String(); got != tt.want {
+				t.Errorf("Direction.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFeature(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		want       Feature
+		wantString string
+		wantErr    error
+	}{
+		{name: "empty", s: "", wantErr: ErrInvalidArgument},
+		{name: "garbage", s: "=", wantErr: ErrInvalidArgument},
+		{name: "enable", s: "liga", want: Feature{Tag: MakeTag("liga"), Value: 1}, wantString: "liga"},
+		{name: "disable", s: "-kern", want: Feature{Tag: MakeTag("kern"), Value: 0}, wantString: "-kern"},
+		{name: "value", s: "aalt=2", want: Feature{Tag: MakeTag("aalt"), Value: 2}, wantString: "aalt=2"},
+		{name: "range", s: "smcp[3:5]", want: Feature{Tag: MakeTag("smcp"), Value: 1, Start: 3, End: 5}, wantString: "smcp[3:5]"},
+		{name: "open range", s: "smcp[3:]", want: Feature{Tag: MakeTag("smcp"), Value: 1, Start: 3, End: ^uint(0)}, wantString: "smcp[3:]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFeature(tt.s)
+			if err != tt.wantErr {
+				t.Fatalf("ParseFeature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFeature() = %v, want %v", got, tt.want)
+			}
+			if err == nil && got.String() != tt.wantString {
+				t.Errorf("Feature.String() = %v, want %v", got.String(), tt.wantString)
+			}
+		})
+	}
+}
+
+func TestParseFeatures(t *testing.T) {
+	got, err := ParseFeatures("liga, -kern,,aalt=2")
+	if err != nil {
+		t.Fatalf("ParseFeatures() error = %v", err)
+	}
+
+	want := []Feature{
+		{Tag: MakeTag("liga"), Value: 1},
+		{Tag: MakeTag("kern"), Value: 0},
+		{Tag: MakeTag("aalt"), Value: 2},
+	}
+	if diff := diff(got, want); diff != nil {
+		t.Errorf("ParseFeatures() = %v", diff)
+	}
+
+	if _, err := ParseFeatures("liga,="); err != ErrInvalidArgument {
+		t.Errorf("ParseFeatures() error = %v, wantErr %v", err, ErrInvalidArgument)
+	}
+}
+
+func TestFace_NewShaper(t *testing.T) {
+	face, err := nilFace()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	if _, err := face.NewShaper(); err != ErrInvalidFaceHandle {
+		t.Errorf("Face.NewShaper() error = %v, wantErr %v", err, ErrInvalidFaceHandle)
+	}
+
+	face, err = goRegular()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+
+	s, err := face.NewShaper()
+	if err != nil {
+		t.Fatalf("Face.NewShaper() error = %v", err)
+	}
+
+	s.SetLoadFlags(LoadNoHinting | LoadNoBitmap)
+	if got, want := s.LoadFlags(), LoadNoHinting|LoadNoBitmap; got != want {
+		t.Errorf("Shaper.LoadFlags() = %v, want %v", got, want)
+	}
+
+	// the face must outlive the shaper, and freeing it must release both.
+	face.Free()
+	if s.ptr != nil {
+		t.Errorf("Face.Free() did not free the shaper")
+	}
+	if _, err := s.Shape("a", ShapeOptions{}); err != ErrInvalidFaceHandle {
+		t.Errorf("Shaper.Shape() error = %v, wantErr %v", err, ErrInvalidFaceHandle)
+	}
+}
+
+func TestShaper_Shape(t *testing.T) {
+	face, err := goRegular()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set size: %v", err)
+	}
+
+	s, err := face.NewShaper()
+	if err != nil {
+		t.Fatalf("unable to create shaper: %v", err)
+	}
+
+	advance := func(r rune) Pos {
+		adv, err := face.Advance(face.CharIndex(r), LoadNoHinting)
+		if err != nil {
+			t.Fatalf("unable to get advance: %v", err)
+		}
+		return (adv + 1<<9) >> 10
+	}
+
+	tests := []struct {
+		name  string
+		text  string
+		opts  ShapeOptions
+		runes []rune
+		want  []int
+	}{
+		{name: "empty", text: "", runes: nil, want: nil},
+		{name: "ltr", text: "Hi!", runes: []rune{'H', 'i', '!'}, want: []int{0, 1, 2}},
+		{
+			name:  "rtl",
+			text:  "Hi!",
+			opts:  ShapeOptions{Direction: DirectionRTL, Script: "Latn", Language: "en"},
+			runes: []rune{'!', 'i', 'H'},
+			want:  []int{2, 1, 0},
+		},
+		{name: "clusters", text: "é!", runes: []rune{'é', '!'}, want: []int{0, 2}},
+		{
+			name:  "features",
+			text:  "Hi!",
+			opts:  ShapeOptions{Features: []Feature{{Tag: MakeTag("kern"), Value: 0}}},
+			runes: []rune{'H', 'i', '!'},
+			want:  []int{0, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Shape(tt.text, tt.opts)
+			if err != nil {
+				t.Fatalf("Shaper.Shape() error = %v", err)
+			}
+
+			if len(got) != len(tt.runes) {
+				t.Fatalf("Shaper.Shape() returned %d glyphs, want %d", len(got), len(tt.runes))
+			}
+
+			for i, r := range tt.runes {
+				want := ShapedGlyph{
+					Index:    face.CharIndex(r),
+					Cluster:  tt.want[i],
+					XAdvance: advance(r),
+				}
+				if got[i] != want {
+					t.Errorf("Shaper.Shape()[%d] = %+v, want %+v", i, got[i], want)
+				}
+			}
+		})
+	}
+}
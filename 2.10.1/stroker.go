@@ -0,0 +1,396 @@
+package freetype2
+
+// #include <stdlib.h>
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include FT_GLYPH_H
+// #include FT_STROKER_H
+import "C"
+
+import (
+	"github.com/flga/freetype2/fixed"
+)
+
+// LineCap lists the possible types of line caps to be drawn at the start and
+// end of an opened path.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_linecap
+type LineCap int
+
+const (
+	// LineCapButt makes the end of lines a square. Note, however, that the
+	// square is the size of the stroke radius, not the full width of the line.
+	LineCapButt LineCap = C.FT_STROKER_LINECAP_BUTT
+	// LineCapRound makes the end of lines a half-circle.
+	LineCapRound LineCap = C.FT_STROKER_LINECAP_ROUND
+	// LineCapSquare makes the end of lines a square that extends beyond the end
+	// point by the stroke radius.
+	LineCapSquare LineCap = C.FT_STROKER_LINECAP_SQUARE
+)
+
+func (c LineCap) String() string {
+	switch c {
+	case LineCapButt:
+		return "Butt"
+	case LineCapRound:
+		return "Round"
+	case LineCapSquare:
+		return "Square"
+	default:
+		return "Unknown"
+	}
+}
+
+// LineJoin lists the possible types of line joins to be drawn between two
+// segments of a path.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_linejoin
+type LineJoin int
+
+const (
+	// LineJoinRound uses circular arcs to join the borders of the stroke.
+	LineJoinRound LineJoin = C.FT_STROKER_LINEJOIN_ROUND
+	// LineJoinBevel uses a straight line to join the borders of the stroke.
+	LineJoinBevel LineJoin = C.FT_STROKER_LINEJOIN_BEVEL
+	// LineJoinMiterVariable is used to render mitered line joins, with variable
+	// bevels if the miter limit is exceeded. The intersection of the strokes is
+	// clipped at a line perpendicular to the bisector of the angle between the
+	// strokes, at the distance from the intersection of the segments equal to
+	// the product of the miter limit value and the border radius.
+	LineJoinMiterVariable LineJoin = C.FT_STROKER_LINEJOIN_MITER_VARIABLE
+	// LineJoinMiter is an alias for LineJoinMiterVariable.
+	LineJoinMiter LineJoin = C.FT_STROKER_LINEJOIN_MITER
+	// LineJoinMiterFixed is used to render mitered line joins, with fixed bevels
+	// if the miter limit is exceeded. If the miter limit is exceeded, the outer
+	// edges of the strokes are extended until they intersect at a distance equal
+	// to the product of the miter limit and the border radius; the join is then
+	// beveled at that distance.
+	LineJoinMiterFixed LineJoin = C.FT_STROKER_LINEJOIN_MITER_FIXED
+)
+
+func (j LineJoin) String() string {
+	switch j {
+	case LineJoinRound:
+		return "Round"
+	case LineJoinBevel:
+		return "Bevel"
+	case LineJoinMiterVariable:
+		return "MiterVariable"
+	case LineJoinMiterFixed:
+		return "MiterFixed"
+	default:
+		return "Unknown"
+	}
+}
+
+// StrokerBorder is used to select a given stroke border.
+//
+// Applications are generally interested in the ‘inside’ and ‘outside’ borders,
+// see Outline.InsideBorder and Outline.OutsideBorder.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_strokerborder
+type StrokerBorder int
+
+const (
+	// StrokerBorderLeft selects the left border, relative to the drawing
+	// direction.
+	StrokerBorderLeft StrokerBorder = C.FT_STROKER_BORDER_LEFT
+	// StrokerBorderRight selects the right border, relative to the drawing
+	// direction.
+	StrokerBorderRight StrokerBorder = C.FT_STROKER_BORDER_RIGHT
+)
+
+func (b StrokerBorder) String() string {
+	switch b {
+	case StrokerBorderLeft:
+		return "Left"
+	case StrokerBorderRight:
+		return "Right"
+	default:
+		return "Unknown"
+	}
+}
+
+// InsideBorder retrieves the StrokerBorder value corresponding to the ‘inside’
+// borders of a given outline.
+//
+// It returns StrokerBorderRight for empty or invalid outlines.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_outline_getinsideborder
+func (o *Outline) InsideBorder() StrokerBorder {
+	if o == nil || o.ptr == nil {
+		return StrokerBorderRight
+	}
+	return StrokerBorder(C.FT_Outline_GetInsideBorder(o.ptr))
+}
+
+// OutsideBorder retrieves the StrokerBorder value corresponding to the
+// ‘outside’ borders of a given outline.
+//
+// It returns StrokerBorderLeft for empty or invalid outlines.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_outline_getoutsideborder
+func (o *Outline) OutsideBorder() StrokerBorder {
+	if o == nil || o.ptr == nil {
+		return StrokerBorderLeft
+	}
+	return StrokerBorder(C.FT_Outline_GetOutsideBorder(o.ptr))
+}
+
+// Stroker is used to create stroked outlines and glyphs.
+//
+// Note that the stroker will be freed, when destroying the library, by
+// Library.Free.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker
+type Stroker struct {
+	ptr C.FT_Stroker
+	l   *Library
+}
+
+// NewStroker creates a new stroker object. Before using it, its parameters
+// must be configured with Set.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_new
+func (l *Library) NewStroker() (*Stroker, error) {
+	if l == nil || l.ptr == nil {
+		return nil, ErrInvalidLibraryHandle
+	}
+
+	var stroker C.FT_Stroker
+	if err := getErr(C.FT_Stroker_New(l.ptr, &stroker)); err != nil {
+		return nil, err
+	}
+
+	ret := &Stroker{ptr: stroker, l: l}
+	l.dealloc = append(l.dealloc, func() { ret.Free() })
+
+	return ret, nil
+}
+
+// Free destroys a stroker object.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_done
+func (s *Stroker) Free() {
+	if s == nil || s.ptr == nil {
+		return
+	}
+
+	C.FT_Stroker_Done(s.ptr)
+	*s = Stroker{}
+}
+
+// Set resets a stroker object's attributes.
+//
+// The radius is expressed in the same units as the outline coordinates, that is
+// usually 26.6 pixels. The miterLimit is only used for LineJoinMiterVariable
+// and LineJoinMiterFixed, it is the maximum reciprocal sine of half-angle at
+// the miter join, expressed as 16.16 fixed-point value.
+//
+// The radius is used to determine the width of the stroke, which is twice the
+// radius.
+//
+// This function calls Rewind automatically.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_set
+func (s *Stroker) Set(radius fixed.Int26_6, lineCap LineCap, lineJoin LineJoin, miterLimit fixed.Int16_16) {
+	if s == nil || s.ptr == nil {
+		return
+	}
+
+	C.FT_Stroker_Set(s.ptr, C.FT_Fixed(radius), C.FT_Stroker_LineCap(lineCap), C.FT_Stroker_LineJoin(lineJoin), C.FT_Fixed(miterLimit))
+}
+
+// Rewind resets a stroker object without changing its attributes. You should
+// call this function before beginning a new series of calls to ParseOutline.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_rewind
+func (s *Stroker) Rewind() {
+	if s == nil || s.ptr == nil {
+		return
+	}
+
+	C.FT_Stroker_Rewind(s.ptr)
+}
+
+// ParseOutline is a convenience function used to parse a whole outline with the
+// stroker. The resulting outline(s) can be retrieved later by functions like
+// Counts and Export.
+//
+// If opened is true, the outline is processed as an open path, and the stroker
+// generates a single ‘stroke’ outline.
+//
+// The function calls Rewind automatically.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_parseoutline
+func (s *Stroker) ParseOutline(o *Outline, opened bool) error {
+	if s == nil || s.ptr == nil {
+		return ErrInvalidArgument
+	}
+
+	if o == nil || o.ptr == nil {
+		return ErrInvalidOutline
+	}
+
+	var copened C.FT_Bool
+	if opened {
+		copened = 1
+	}
+
+	return getErr(C.FT_Stroker_ParseOutline(s.ptr, o.ptr, copened))
+}
+
+// Counts returns the number of points and contours required to export all
+// borders of the stroked outline.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_getcounts
+func (s *Stroker) Counts() (points, contours int, err error) {
+	if s == nil || s.ptr == nil {
+		return 0, 0, ErrInvalidArgument
+	}
+
+	var cpoints, ccontours C.FT_UInt
+	if err := getErr(C.FT_Stroker_GetCounts(s.ptr, &cpoints, &ccontours)); err != nil {
+		return 0, 0, err
+	}
+
+	return int(cpoints), int(ccontours), nil
+}
+
+// BorderCounts returns the number of points and contours required to export
+// one of the ‘border’ or ‘stroke’ outlines generated by the stroker.
+//
+// When an outline, or a sub-path, is ‘closed’, the stroker generates two
+// independent ‘border’ outlines, named ‘left’ and ‘right’.
+//
+// When the outline, or a sub-path, is ‘opened’, the stroker merges the ‘border’
+// outlines with caps. The ‘left’ border receives all points, while the ‘right’
+// border becomes empty.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_getbordercounts
+func (s *Stroker) BorderCounts(border StrokerBorder) (points, contours int, err error) {
+	if s == nil || s.ptr == nil {
+		return 0, 0, ErrInvalidArgument
+	}
+
+	var cpoints, ccontours C.FT_UInt
+	if err := getErr(C.FT_Stroker_GetBorderCounts(s.ptr, C.FT_StrokerBorder(border), &cpoints, &ccontours)); err != nil {
+		return 0, 0, err
+	}
+
+	return int(cpoints), int(ccontours), nil
+}
+
+// Export returns a new outline holding all borders of the stroked outline.
+//
+// The returned outline is created with Library.NewOutline, so the same
+// ownership rules apply.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_export
+func (s *Stroker) Export() (*Outline, error) {
+	points, contours, err := s.Counts()
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := s.l.NewOutline(points, contours)
+	if err != nil {
+		return nil, err
+	}
+
+	o.ptr.n_points = 0
+	o.ptr.n_contours = 0
+	C.FT_Stroker_Export(s.ptr, o.ptr)
+	o.reload()
+
+	return o, nil
+}
+
+// ExportBorder returns a new outline holding a single border of the stroked
+// outline.
+//
+// The returned outline is created with Library.NewOutline, so the same
+// ownership rules apply.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-glyph_stroker.html#ft_stroker_exportborder
+func (s *Stroker) ExportBorder(border StrokerBorder) (*Outline, error) {
+	points, contours, err := s.BorderCounts(border)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := s.l.NewOutline(points, contours)
+	if err != nil {
+		return nil, err
+	}
+
+	o.ptr.n_points = 0
+	o.ptr.n_contours = 0
+	C.FT_Stroker_ExportBorder(s.ptr, C.FT_StrokerBorder(border), o.ptr)
+	o.reload()
+
+	return o, nil
+}
+
+func glyphStroke(g Glyph, s *Stroker, destroy bool) (*OutlineGlyph, error) {
+	if g == nil || g.getptr() == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	if s == nil || s.ptr == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	var cdestroy C.FT_Bool
+	if destroy {
+		cdestroy = 1
+	}
+
+	target := g.getptr()
+	if err := getErr(C.FT_Glyph_Stroke(&target, s.ptr, cdestroy)); err != nil {
+		return nil, err
+	}
+
+	if destroy {
+		g.reset()
+	}
+	g.reload()
+
+	ret := &OutlineGlyph{ptr: target}
+	ret.reload()
+	return ret, nil
+}
+
+func glyphStrokeBorder(g Glyph, s *Stroker, inside, destroy bool) (*OutlineGlyph, error) {
+	if g == nil || g.getptr() == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	if s == nil || s.ptr == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	var cinside C.FT_Bool
+	if inside {
+		cinside = 1
+	}
+
+	var cdestroy C.FT_Bool
+	if destroy {
+		cdestroy = 1
+	}
+
+	target := g.getptr()
+	if err := getErr(C.FT_Glyph_StrokeBorder(&target, s.ptr, cinside, cdestroy)); err != nil {
+		return nil, err
+	}
+
+	if destroy {
+		g.reset()
+	}
+	g.reload()
+
+	ret := &OutlineGlyph{ptr: target}
+	ret.reload()
+	return ret, nil
+}
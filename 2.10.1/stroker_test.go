@@ -0,0 +1,330 @@
+package freetype2
+
+import (
+	"testing"
+)
+
+func TestLineCap_String(t *testing.T) {
+	tests := []struct {
+		name string
+		c    LineCap
+		want string
+	}{
+		{name: "Butt", c: LineCapButt, want: "Butt"},
+		{name: "Round", c: LineCapRound, want: "Round"},
+		{name: "Square", c: LineCapSquare, want: "Square"},
+		{name: "Unknown", c: 901929, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.String(); got != tt.want {
+				t.Errorf("LineCap.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineJoin_String(t *testing.T) {
+	tests := []struct {
+		name string
+		j    LineJoin
+		want string
+	}{
+		{name: "Round", j: LineJoinRound, want: "Round"},
+		{name: "Bevel", j: LineJoinBevel, want: "Bevel"},
+		{name: "MiterVariable", j: LineJoinMiterVariable, want: "MiterVariable"},
+		{name: "Miter", j: LineJoinMiter, want: "MiterVariable"},
+		{name: "MiterFixed", j: LineJoinMiterFixed, want: "MiterFixed"},
+		{name: "Unknown", j: 901929, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.j.String(); got != tt.want {
+				t.Errorf("LineJoin.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrokerBorder_String(t *testing.T) {
+	tests := []struct {
+		name string
+		b    StrokerBorder
+		want string
+	}{
+		{name: "Left", b: StrokerBorderLeft, want: "Left"},
+		{name: "Right", b: StrokerBorderRight, want: "Right"},
+		{name: "Unknown", b: 901929, want: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.b.String(); got != tt.want {
+				t.Errorf("StrokerBorder.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutline_Borders(t *testing.T) {
+	glyph, err := newTestGlyph(goRegular, 'O', LoadDefault, 14<<6, 72)()
+	if err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+	defer glyph.Free()
+
+	outline := glyph.Glyph.(*OutlineGlyph).Outline
+
+	tests := []struct {
+		name        string
+		outline     *Outline
+		wantInside  StrokerBorder
+		wantOutside StrokerBorder
+	}{
+		{name: "nil outline", outline: nil, wantInside: StrokerBorderRight, wantOutside: StrokerBorderLeft},
+		{name: "truetype", outline: outline, wantInside: StrokerBorderRight, wantOutside: StrokerBorderLeft},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.outline.InsideBorder(); got != tt.wantInside {
+				t.Errorf("Outline.InsideBorder() = %v, want %v", got, tt.wantInside)
+			}
+			if got := tt.outline.OutsideBorder(); got != tt.wantOutside {
+				t.Errorf("Outline.OutsideBorder() = %v, want %v", got, tt.wantOutside)
+			}
+		})
+	}
+}
+
+func TestLibrary_NewStroker(t *testing.T) {
+	var nilLib *Library
+	if _, err := nilLib.NewStroker(); err != ErrInvalidLibraryHandle {
+		t.Errorf("Library.NewStroker() error = %v, wantErr %v", err, ErrInvalidLibraryHandle)
+	}
+
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+
+	s, err := l.NewStroker()
+	if err != nil {
+		t.Fatalf("Library.NewStroker() error = %v", err)
+	}
+	if s.ptr == nil {
+		t.Fatalf("Library.NewStroker() returned a nil stroker")
+	}
+
+	s.Free()
+	if s.ptr != nil {
+		t.Errorf("Stroker.Free() did not clear the stroker")
+	}
+
+	// must not double free
+	l.Free()
+}
+
+func TestStroker_Export(t *testing.T) {
+	glyph, err := newTestGlyph(goRegular, 'O', LoadDefault, 14<<6, 72)()
+	if err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+	defer glyph.Free()
+
+	outline := glyph.Glyph.(*OutlineGlyph).Outline
+
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+	defer l.Free()
+
+	s, err := l.NewStroker()
+	if err != nil {
+		t.Fatalf("unable to create stroker: %v", err)
+	}
+	s.Set(1<<6, LineCapRound, LineJoinRound, 0)
+
+	var nilStroker *Stroker
+	if err := nilStroker.ParseOutline(outline, false); err != ErrInvalidArgument {
+		t.Errorf("Stroker.ParseOutline() error = %v, wantErr %v", err, ErrInvalidArgument)
+	}
+	if err := s.ParseOutline(nil, false); err != ErrInvalidOutline {
+		t.Errorf("Stroker.ParseOutline() error = %v, wantErr %v", err, ErrInvalidOutline)
+	}
+	if _, err := nilStroker.Export(); err != ErrInvalidArgument {
+		t.Errorf("Stroker.Export() error = %v, wantErr %v", err, ErrInvalidArgument)
+	}
+
+	if err := s.ParseOutline(outline, false); err != nil {
+		t.Fatalf("Stroker.ParseOutline() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		export       func() (*Outline, error)
+		counts       func() (int, int, error)
+		wantPoints   int
+		wantContours []int16
+		wantCBox     BBox
+	}{
+		{
+			name:         "all",
+			export:       s.Export,
+			counts:       s.Counts,
+			wantPoints:   146,
+			wantContours: []int16{31, 69, 107, 145},
+			wantCBox:     BBox{XMin: -23, YMin: -64, XMax: 721, YMax: 768},
+		},
+		{
+			name:         "left",
+			export:       func() (*Outline, error) { return s.ExportBorder(StrokerBorderLeft) },
+			counts:       func() (int, int, error) { return s.BorderCounts(StrokerBorderLeft) },
+			wantPoints:   70,
+			wantContours: []int16{31, 69},
+			wantCBox:     BBox{XMin: -23, YMin: -64, XMax: 721, YMax: 768},
+		},
+		{
+			name:         "right",
+			export:       func() (*Outline, error) { return s.ExportBorder(StrokerBorderRight) },
+			counts:       func() (int, int, error) { return s.BorderCounts(StrokerBorderRight) },
+			wantPoints:   76,
+			wantContours: []int16{37, 75},
+			wantCBox:     BBox{XMin: 75, YMin: 4, XMax: 622, YMax: 700},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, contours, err := tt.counts()
+			if err != nil {
+				t.Fatalf("counts error = %v", err)
+			}
+			if points != tt.wantPoints || contours != len(tt.wantContours) {
+				t.Errorf("counts = %d, %d, want %d, %d", points, contours, tt.wantPoints, len(tt.wantContours))
+			}
+
+			got, err := tt.export()
+			if err != nil {
+				t.Fatalf("export error = %v", err)
+			}
+			defer got.Free()
+
+			if len(got.Points) != tt.wantPoints {
+				t.Errorf("export points = %d, want %d", len(got.Points), tt.wantPoints)
+			}
+			if diff := diff(got.Contours, tt.wantContours); diff != nil {
+				t.Errorf("export contours = %v", diff)
+			}
+			if got := got.CBox(); got != tt.wantCBox {
+				t.Errorf("export cbox = %v, want %v", got, tt.wantCBox)
+			}
+		})
+	}
+}
+
+func TestGlyph_Stroke(t *testing.T) {
+	outline := newTestGlyph(goRegular, 'O', LoadDefault, 14<<6, 72)
+	bitmap := newTestGlyph(goRegular, 'O', LoadRender, 14<<6, 72)
+
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %s", err)
+	}
+	defer l.Free()
+
+	s, err := l.NewStroker()
+	if err != nil {
+		t.Fatalf("unable to create stroker: %v", err)
+	}
+	s.Set(1<<6, LineCapRound, LineJoinRound, 0)
+
+	tests := []struct {
+		name         string
+		glyph        func() (testglyph, error)
+		stroker      *Stroker
+		border       bool
+		inside       bool
+		destroy      bool
+		wantContours []int16
+		wantCBox     BBox
+		wantErr      error
+	}{
+		{name: "emptyGlyph", glyph: newZeroGlyph(GlyphFormatOutline), stroker: s, wantErr: ErrInvalidArgument},
+		{name: "nil stroker", glyph: outline, stroker: nil, wantErr: ErrInvalidArgument},
+		{name: "bitmap", glyph: bitmap, stroker: s, wantErr: ErrInvalidArgument},
+		{
+			name:         "stroke",
+			glyph:        outline,
+			stroker:      s,
+			wantContours: []int16{31, 69, 107, 145},
+			wantCBox:     BBox{XMin: -23, YMin: -64, XMax: 721, YMax: 768},
+		},
+		{
+			name:         "stroke destroy",
+			glyph:        outline,
+			stroker:      s,
+			destroy:      true,
+			wantContours: []int16{31, 69, 107, 145},
+			wantCBox:     BBox{XMin: -23, YMin: -64, XMax: 721, YMax: 768},
+		},
+		{
+			name:         "outside border",
+			glyph:        outline,
+			stroker:      s,
+			border:       true,
+			wantContours: []int16{31, 69},
+			wantCBox:     BBox{XMin: -23, YMin: -64, XMax: 721, YMax: 768},
+		},
+		{
+			name:         "inside border",
+			glyph:        outline,
+			stroker:      s,
+			border:       true,
+			inside:       true,
+			wantContours: []int16{37, 75},
+			wantCBox:     BBox{XMin: 75, YMin: 4, XMax: 622, YMax: 700},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			glyph, err := tt.glyph()
+			if err != nil {
+				t.Fatalf("unable to load glyph: %v", err)
+			}
+			defer glyph.Free()
+
+			var got *OutlineGlyph
+			if tt.border {
+				got, err = glyph.StrokeBorder(tt.stroker, tt.inside, tt.destroy)
+			} else {
+				got, err = glyph.Stroke(tt.stroker, tt.destroy)
+			}
+			if err != tt.wantErr {
+				t.Fatalf("Glyph.Stroke() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if got != nil {
+					t.Errorf("Glyph.Stroke() = %v, want nil", got)
+				}
+				return
+			}
+			defer got.Free()
+
+			if tt.destroy && glyph.getptr() != nil {
+				t.Errorf("Glyph.Stroke() did not destroy the original glyph")
+			}
+			if !tt.destroy && glyph.getptr() == nil {
+				t.Errorf("Glyph.Stroke() destroyed the original glyph")
+			}
+
+			if got.Format() != GlyphFormatOutline {
+				t.Errorf("Glyph.Stroke() format = %v, want %v", got.Format(), GlyphFormatOutline)
+			}
+			if diff := diff(got.Outline.Contours, tt.wantContours); diff != nil {
+				t.Errorf("Glyph.Stroke() contours = %v", diff)
+			}
+			if got := got.CBox(GlyphBBoxUnscaled); got != tt.wantCBox {
+				t.Errorf("Glyph.Stroke() cbox = %v, want %v", got, tt.wantCBox)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// +build harfbuzz,subset
+
+package freetype2
+
+// #cgo !static pkg-config: harfbuzz-subset
+// #cgo static CFLAGS: -DFREETYPE2_HARFBUZZ_STATIC
+//
+// #include <stdlib.h>
+// #ifdef FREETYPE2_HARFBUZZ_STATIC
+// #include "harfbuzz_subset.h"
+// #else
+// #include <hb.h>
+// #include <hb-subset.h>
+// #endif
+//
+// #if !HB_VERSION_ATLEAST(2, 5, 3)
+// #error "subsetting requires HarfBuzz 2.5.3 or later"
+// #endif
+//
+// /* HarfBuzz 2.9.0 replaced the accessors of the subset input with flags and
+//    set types, and 3.0.0 removed them. */
+// #if HB_VERSION_ATLEAST(2, 9, 0)
+// static hb_set_t *subset_input_nameid_set(hb_subset_input_t *input) {
+// 	return hb_subset_input_set(input, HB_SUBSET_SETS_NAME_ID);
+// }
+//
+// static hb_set_t *subset_input_drop_tables_set(hb_subset_input_t *input) {
+// 	return hb_subset_input_set(input, HB_SUBSET_SETS_DROP_TABLE_TAG);
+// }
+//
+// static void subset_input_set_flag(hb_subset_input_t *input, hb_subset_flags_t flag, hb_bool_t value) {
+// 	unsigned int flags = hb_subset_input_get_flags(input);
+// 	flags = value ? flags | flag : flags & ~flag;
+// 	hb_subset_input_set_flags(input, flags);
+// }
+//
+// static void subset_input_set_drop_hints(hb_subset_input_t *input, hb_bool_t value) {
+// 	subset_input_set_flag(input, HB_SUBSET_FLAGS_NO_HINTING, value);
+// }
+//
+// static void subset_input_set_retain_gids(hb_subset_input_t *input, hb_bool_t value) {
+// 	subset_input_set_flag(input, HB_SUBSET_FLAGS_RETAIN_GIDS, value);
+// }
+//
+// static hb_face_t *subset(hb_face_t *source, hb_subset_input_t *input) {
+// 	return hb_subset_or_fail(source, input);
+// }
+// #else
+// static hb_set_t *subset_input_nameid_set(hb_subset_input_t *input) {
+// 	return hb_subset_input_nameid_set(input);
+// }
+//
+// static hb_set_t *subset_input_drop_tables_set(hb_subset_input_t *input) {
+// 	return hb_subset_input_drop_tables_set(input);
+// }
+//
+// static void subset_input_set_drop_hints(hb_subset_input_t *input, hb_bool_t value) {
+// 	hb_subset_input_set_drop_hints(input, value);
+// }
+//
+// static void subset_input_set_retain_gids(hb_subset_input_t *input, hb_bool_t value) {
+// 	hb_subset_input_set_retain_gids(input, value);
+// }
+//
+// static hb_face_t *subset(hb_face_t *source, hb_subset_input_t *input) {
+// 	return hb_subset(source, input);
+// }
+// #endif
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/flga/freetype2/2.10.1/truetype"
+)
+
+// ErrSubsetFailed occurs when HarfBuzz is unable to subset a font.
+var ErrSubsetFailed = errors.New("unable to subset font")
+
+// layoutTables are the OpenType layout tables kept by SubsetOptions.KeepLayout.
+var layoutTables = []Tag{MakeTag("GSUB"), MakeTag("GPOS"), MakeTag("GDEF")}
+
+// SubsetOptions controls which parts of a font are kept by Subset.
+//
+// The ‘.notdef’ glyph is always kept. Glyphs needed by the ones requested,
+// like the components of composite glyphs or, when the layout tables are kept,
+// the substitutes of the requested glyphs, are kept as well.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-subset.html
+type SubsetOptions struct {
+	// The characters to keep, mapped to glyphs through the font's cmap.
+	Runes []rune
+	// The glyphs to keep, in addition to the ones mapped from Runes.
+	Glyphs []GlyphIndex
+	// RetainGlyphIndices keeps the original glyph indices, replacing the glyphs
+	// that are not kept with empty ones. By default the kept glyphs are
+	// renumbered.
+	RetainGlyphIndices bool
+	// DropHints removes the hinting instructions and tables.
+	DropHints bool
+	// KeepLayout keeps the GSUB, GPOS and GDEF tables, subset to the kept
+	// glyphs, so that the result can still be shaped. They are dropped by
+	// default.
+	KeepLayout bool
+	// The name table entries to keep. If nil, HarfBuzz's defaults are used,
+	// which are the IDs NameIDCopyright to NameIDPsName.
+	NameIDs []truetype.NameID
+}
+
+// Subset creates a subset of the SFNT font at index in data, returning the
+// bytes of the new font file, which can be opened with Library.NewFace.
+//
+// It returns ErrSubsetFailed if HarfBuzz is unable to parse or subset the font.
+//
+// It requires HarfBuzz 2.5.3 or later, static builds use the bundled 2.5.3.
+//
+// See https://harfbuzz.github.io/harfbuzz-hb-subset.html#hb-subset
+func Subset(data []byte, index int, opts SubsetOptions) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	if index < 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	cdata := C.CBytes(data)
+	defer free(cdata)
+
+	blob := C.hb_blob_create((*C.char)(cdata), C.uint(len(data)), C.HB_MEMORY_MODE_READONLY, nil, nil)
+	defer C.hb_blob_destroy(blob)
+
+	face := C.hb_face_create(blob, C.uint(index))
+	defer C.hb_face_destroy(face)
+
+	if face == C.hb_face_get_empty() || C.hb_face_get_glyph_count(face) == 0 {
+		return nil, ErrSubsetFailed
+	}
+
+	input := C.hb_subset_input_create_or_fail()
+	if input == nil {
+		return nil, ErrOutOfMemory
+	}
+	defer C.hb_subset_input_destroy(input)
+
+	unicodes := C.hb_subset_input_unicode_set(input)
+	for _, r := range opts.Runes {
+		C.hb_set_add(unicodes, C.hb_codepoint_t(r))
+	}
+
+	glyphs := C.hb_subset_input_glyph_set(input)
+	for _, g := range opts.Glyphs {
+		C.hb_set_add(glyphs, C.hb_codepoint_t(g))
+	}
+
+	if opts.NameIDs != nil {
+		nameIDs := C.subset_input_nameid_set(input)
+		C.hb_set_clear(nameIDs)
+		for _, id := range opts.NameIDs {
+			C.hb_set_add(nameIDs, C.hb_codepoint_t(id))
+		}
+	}
+
+	dropTables := C.subset_input_drop_tables_set(input)
+	for _, tag := range layoutTables {
+		if opts.KeepLayout {
+			C.hb_set_del(dropTables, C.hb_codepoint_t(tag))
+		} else {
+			C.hb_set_add(dropTables, C.hb_codepoint_t(tag))
+		}
+	}
+
+	C.subset_input_set_drop_hints(input, cbool(opts.DropHints))
+	C.subset_input_set_retain_gids(input, cbool(opts.RetainGlyphIndices))
+
+	result := C.subset(face, input)
+	if result == nil {
+		return nil, ErrSubsetFailed
+	}
+	defer C.hb_face_destroy(result)
+
+	if result == C.hb_face_get_empty() {
+		return nil, ErrSubsetFailed
+	}
+
+	out := C.hb_face_reference_blob(result)
+	defer C.hb_blob_destroy(out)
+
+	var n C.uint
+	ptr := C.hb_blob_get_data(out, &n)
+	if ptr == nil || n == 0 {
+		return nil, ErrSubsetFailed
+	}
+
+	return C.GoBytes(unsafe.Pointer(ptr), C.int(n)), nil
+}
+
+// Subset creates a subset of the face's font file, see Subset for details.
+//
+// It returns ErrTableMissing if the face is not SFNT-based.
+func (f *Face) Subset(opts SubsetOptions) ([]byte, error) {
+	if f == nil || f.ptr == nil {
+		return nil, ErrInvalidFaceHandle
+	}
+
+	data, err := f.LoadSfntTable(0, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// WOFF and WOFF2 fonts are returned decompressed, as a single face.
+	index := f.Index()
+	if len(data) < 4 || string(data[:4]) != "ttcf" {
+		index = 0
+	}
+
+	return Subset(data, index, opts)
+}
+
+func cbool(b bool) C.hb_bool_t {
+	if b {
+		return 1
+	}
+	return 0
+}
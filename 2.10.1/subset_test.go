@@ -0,0 +1,207 @@
+// +build harfbuzz,subset
+
+package freetype2
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/flga/freetype2/2.10.1/truetype"
+)
+
+func TestSubset(t *testing.T) {
+	regular, err := ioutil.ReadFile(testdata("go", "Go-Regular.ttf"))
+	if err != nil {
+		t.Fatalf("unable to read font: %v", err)
+	}
+	bold, err := ioutil.ReadFile(testdata("go", "Go-Bold.ttf"))
+	if err != nil {
+		t.Fatalf("unable to read font: %v", err)
+	}
+	collection := makeCollection(regular, bold)
+
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to init lib: %v", err)
+	}
+	defer l.Free()
+
+	orig, err := l.NewFace(bytes.NewReader(regular), 0, 0)
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	origGlyph := orig.CharIndex('i')
+
+	tests := []struct {
+		name       string
+		data       []byte
+		index      int
+		opts       SubsetOptions
+		wantErr    error
+		wantGlyphs int
+		wantStyle  string
+		wantRunes  map[rune]GlyphIndex
+		wantTables map[string]bool
+		wantNames  []truetype.NameID
+	}{
+		{name: "no data", data: nil, wantErr: ErrInvalidArgument},
+		{name: "negative index", data: regular, index: -1, wantErr: ErrInvalidArgument},
+		{name: "garbage", data: []byte("not a font"), wantErr: ErrSubsetFailed},
+		{
+			name:       "runes",
+			data:       regular,
+			opts:       SubsetOptions{Runes: []rune("Hi")},
+			wantGlyphs: 3,
+			wantStyle:  "Regular",
+			wantRunes:  map[rune]GlyphIndex{'H': 1, 'i': 2, 'a': 0},
+			wantTables: map[string]bool{"glyf": true, "fpgm": true, "GSUB": false},
+		},
+		{
+			name:       "glyphs",
+			data:       regular,
+			opts:       SubsetOptions{Glyphs: []GlyphIndex{origGlyph}},
+			wantGlyphs: 2,
+			wantStyle:  "Regular",
+			wantRunes:  map[rune]GlyphIndex{'i': 1, 'H': 0},
+		},
+		{
+			name:       "retain glyph indices",
+			data:       regular,
+			opts:       SubsetOptions{Runes: []rune("i"), RetainGlyphIndices: true},
+			wantGlyphs: int(origGlyph) + 1,
+			wantStyle:  "Regular",
+			wantRunes:  map[rune]GlyphIndex{'i': origGlyph, 'H': 0},
+		},
+		{
+			name:       "drop hints",
+			data:       regular,
+			opts:       SubsetOptions{Runes: []rune("Hi"), DropHints: true},
+			wantGlyphs: 3,
+			wantStyle:  "Regular",
+			wantTables: map[string]bool{"glyf": true, "fpgm": false, "prep": false},
+		},
+		{
+			name:       "name ids",
+			data:       regular,
+			opts:       SubsetOptions{Runes: []rune("Hi"), NameIDs: []truetype.NameID{truetype.NameIDFontFamily}},
+			wantGlyphs: 3,
+			wantNames:  []truetype.NameID{truetype.NameIDFontFamily},
+		},
+		{
+			name:       "collection",
+			data:       collection,
+			index:      1,
+			opts:       SubsetOptions{Runes: []rune("Hi")},
+			wantGlyphs: 3,
+			wantStyle:  "Bold",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Subset(tt.data, tt.index, tt.opts)
+			if err != tt.wantErr {
+				t.Fatalf("Subset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			face, err := l.NewFace(bytes.NewReader(got), 0, 0)
+			if err != nil {
+				t.Fatalf("unable to open subset: %v", err)
+			}
+			defer face.Free()
+
+			if face.NumGlyphs() != tt.wantGlyphs {
+				t.Errorf("Subset() num glyphs = %v, want %v", face.NumGlyphs(), tt.wantGlyphs)
+			}
+			if tt.wantStyle != "" && face.StyleName() != tt.wantStyle {
+				t.Errorf("Subset() style = %v, want %v", face.StyleName(), tt.wantStyle)
+			}
+			for r, want := range tt.wantRunes {
+				if got := face.CharIndex(r); got != want {
+					t.Errorf("Subset() CharIndex(%q) = %v, want %v", r, got, want)
+				}
+			}
+			for tag, want := range tt.wantTables {
+				_, err := face.LoadSfntTable(MakeTag(tag), 0, 0)
+				if got := err == nil; got != want {
+					t.Errorf("Subset() has table %s = %v, want %v", tag, got, want)
+				}
+			}
+			if tt.wantNames != nil {
+				var names []truetype.NameID
+				seen := map[truetype.NameID]bool{}
+				for i := 0; i < face.SfntNameCount(); i++ {
+					name, err := face.SfntName(i)
+					if err != nil {
+						t.Fatalf("unable to read name: %v", err)
+					}
+					if !seen[name.NameID] {
+						seen[name.NameID] = true
+						names = append(names, name.NameID)
+					}
+				}
+				if diff := diff(names, tt.wantNames); diff != nil {
+					t.Errorf("Subset() names = %v", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFace_Subset(t *testing.T) {
+	face, err := nilFace()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	if _, err := face.Subset(SubsetOptions{}); err != ErrInvalidFaceHandle {
+		t.Errorf("Face.Subset() error = %v, wantErr %v", err, ErrInvalidFaceHandle)
+	}
+
+	face, err = nimbusMono()
+	if err != nil {
+		t.Fatalf("unable to open font: %v", err)
+	}
+	defer face.Free()
+	if _, err := face.Subset(SubsetOptions{}); err != ErrTableMissing {
+		t.Errorf("Face.Subset() error = %v, wantErr %v", err, ErrTableMissing)
+	}
+
+	tests := []struct {
+		name      string
+		face      func() (testface, error)
+		wantStyle string
+	}{
+		{name: "ttf", face: goRegular, wantStyle: "Regular"},
+		{name: "collection", face: goCollection(1), wantStyle: "Bold"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to open font: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.Subset(SubsetOptions{Runes: []rune("A")})
+			if err != nil {
+				t.Fatalf("Face.Subset() error = %v", err)
+			}
+
+			subset, err := face.l.NewFace(bytes.NewReader(got), 0, 0)
+			if err != nil {
+				t.Fatalf("unable to open subset: %v", err)
+			}
+			defer subset.Free()
+
+			if subset.StyleName() != tt.wantStyle {
+				t.Errorf("Face.Subset() style = %v, want %v", subset.StyleName(), tt.wantStyle)
+			}
+			if subset.CharIndex('A') == 0 {
+				t.Errorf("Face.Subset() did not keep 'A'")
+			}
+		})
+	}
+}
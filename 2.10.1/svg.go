@@ -0,0 +1,233 @@
+package freetype2
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SVGPath converts the outline to SVG path data, like "M0 -10L5 0Z".
+//
+// Coordinates are multiplied by scale, use 1.0/64 to get pixels out of 26.6
+// fixed-point units. The y axis is flipped, as it points downwards in SVG, so
+// that the outline's origin lies on the baseline at (0, 0).
+//
+// Note that the fill rule of the outline is not part of the path data, see the
+// OutlineEvenOddFill flag.
+func (o *Outline) SVGPath(scale float64) (string, error) {
+	if o == nil || o.ptr == nil {
+		return "", ErrInvalidOutline
+	}
+
+	var b strings.Builder
+	if err := o.writeSVGPath(&b, scale, Vector{}); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func (o *Outline) writeSVGPath(b *strings.Builder, scale float64, offset Vector) error {
+	w := &svgPathWriter{b: b, scale: scale, offset: offset}
+	if err := o.Decompose(w, 0, 0); err != nil {
+		return err
+	}
+
+	w.close()
+	return nil
+}
+
+// svgPathWriter is an OutlineDecomposer that writes SVG path data.
+type svgPathWriter struct {
+	b      *strings.Builder
+	scale  float64
+	offset Vector
+	open   bool
+}
+
+func (w *svgPathWriter) MoveTo(to Vector) error {
+	w.close()
+	w.open = true
+	w.cmd('M', to)
+	return nil
+}
+
+func (w *svgPathWriter) LineTo(to Vector) error {
+	w.cmd('L', to)
+	return nil
+}
+
+func (w *svgPathWriter) ConicTo(control, to Vector) error {
+	w.cmd('Q', control, to)
+	return nil
+}
+
+func (w *svgPathWriter) CubicTo(control1, control2, to Vector) error {
+	w.cmd('C', control1, control2, to)
+	return nil
+}
+
+func (w *svgPathWriter) close() {
+	if w.open {
+		w.b.WriteByte('Z')
+		w.open = false
+	}
+}
+
+func (w *svgPathWriter) cmd(c byte, points ...Vector) {
+	w.b.WriteByte(c)
+	for i, p := range points {
+		if i > 0 {
+			w.b.WriteByte(' ')
+		}
+		w.b.WriteString(svgNumber(float64(p.X+w.offset.X) * w.scale))
+		w.b.WriteByte(' ')
+		w.b.WriteString(svgNumber(-float64(p.Y+w.offset.Y) * w.scale))
+	}
+}
+
+func svgNumber(v float64) string {
+	if v == 0 {
+		return "0" // avoid -0
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// SVGGlyph is a glyph to draw with Face.WriteSVG.
+type SVGGlyph struct {
+	// The glyph index in the face.
+	Index GlyphIndex
+	// The position of the glyph's origin, in 26.6 pixels, with the y axis
+	// pointing upwards like glyph outlines.
+	X, Y Pos
+}
+
+// SVGOptions controls how Face.WriteSVG draws glyphs.
+type SVGOptions struct {
+	// The flags used to load the glyphs, LoadNoBitmap is always added.
+	LoadFlags LoadFlag
+	// The fill color, also used for color layers referencing the text
+	// foreground color. Defaults to opaque black.
+	Foreground color.Color
+	// ColorLayers draws the layers of color glyphs from the ‘COLR’ table as
+	// separate paths, filled with colors from Palette.
+	ColorLayers bool
+	// The palette used for color layers, usually the one returned by
+	// Face.SelectPalette. Color indices out of its range use Foreground.
+	Palette []color.RGBA
+}
+
+// WriteSVG writes a standalone SVG document drawing the glyphs' outlines, one
+// path per glyph, or per layer of color glyphs when opts.ColorLayers is set.
+//
+// The document is sized to the bounding box of all the glyphs, in pixels, so
+// the face's size should be set beforehand. Glyphs without an outline, like
+// spaces or those of bitmap-only fonts, are not drawn.
+func (f *Face) WriteSVG(w io.Writer, glyphs []SVGGlyph, opts SVGOptions) error {
+	if f == nil || f.ptr == nil {
+		return ErrInvalidFaceHandle
+	}
+
+	if w == nil {
+		return ErrInvalidArgument
+	}
+
+	foreground := color.NRGBA{A: 0xff}
+	if opts.Foreground != nil {
+		foreground = color.NRGBAModel.Convert(opts.Foreground).(color.NRGBA)
+	}
+
+	var (
+		paths  strings.Builder
+		bbox   BBox
+		inited bool
+	)
+	writePath := func(idx GlyphIndex, offset Vector, fill color.NRGBA) error {
+		if err := f.LoadGlyph(idx, opts.LoadFlags|LoadNoBitmap); err != nil {
+			return err
+		}
+
+		o := f.GlyphSlot().Outline
+		if len(o.Points) == 0 || f.GlyphSlot().Format != GlyphFormatOutline {
+			return nil
+		}
+
+		b := o.BBox()
+		b.XMin, b.XMax = b.XMin+offset.X, b.XMax+offset.X
+		b.YMin, b.YMax = b.YMin+offset.Y, b.YMax+offset.Y
+		if !inited {
+			bbox, inited = b, true
+		}
+		if b.XMin < bbox.XMin {
+			bbox.XMin = b.XMin
+		}
+		if b.YMin < bbox.YMin {
+			bbox.YMin = b.YMin
+		}
+		if b.XMax > bbox.XMax {
+			bbox.XMax = b.XMax
+		}
+		if b.YMax > bbox.YMax {
+			bbox.YMax = b.YMax
+		}
+
+		paths.WriteString(`<path d="`)
+		if err := o.writeSVGPath(&paths, 1.0/64, offset); err != nil {
+			return err
+		}
+		paths.WriteString(`"`)
+		writeSVGFill(&paths, fill)
+		if o.Flags&OutlineEvenOddFill == OutlineEvenOddFill {
+			paths.WriteString(` fill-rule="evenodd"`)
+		}
+		paths.WriteString("/>\n")
+		return nil
+	}
+
+	for _, g := range glyphs {
+		offset := Vector{X: g.X, Y: g.Y}
+
+		var layers []ColorLayer
+		if opts.ColorLayers {
+			layers = f.GetColorGlyphLayers(g.Index)
+		}
+
+		if len(layers) == 0 {
+			if err := writePath(g.Index, offset, foreground); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, layer := range layers {
+			fill := foreground
+			if layer.ColorIndex >= 0 && layer.ColorIndex < len(opts.Palette) {
+				// palette colors are not premultiplied.
+				c := opts.Palette[layer.ColorIndex]
+				fill = color.NRGBA{R: c.R, G: c.G, B: c.B, A: c.A}
+			}
+			if err := writePath(GlyphIndex(layer.GlyphIndex), offset, fill); err != nil {
+				return err
+			}
+		}
+	}
+
+	x, y := float64(bbox.XMin)/64, -float64(bbox.YMax)/64
+	width, height := float64(bbox.XMax-bbox.XMin)/64, float64(bbox.YMax-bbox.YMin)/64
+
+	_, err := fmt.Fprintf(w, "<svg xmlns=\"http://www.w3.org/2000/svg\" viewBox=\"%s %s %s %s\" width=\"%s\" height=\"%s\">\n%s</svg>\n",
+		svgNumber(x), svgNumber(y), svgNumber(width), svgNumber(height),
+		svgNumber(width), svgNumber(height),
+		paths.String(),
+	)
+	return err
+}
+
+func writeSVGFill(b *strings.Builder, c color.NRGBA) {
+	fmt.Fprintf(b, ` fill="#%02x%02x%02x"`, c.R, c.G, c.B)
+	if c.A != 0xff {
+		fmt.Fprintf(b, ` fill-opacity="%s"`, strconv.FormatFloat(float64(c.A)/0xff, 'g', 3, 64))
+	}
+}
@@ -0,0 +1,262 @@
+package freetype2
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"testing"
+)
+
+func TestOutline_SVGPath(t *testing.T) {
+	l, err := NewLibrary()
+	if err != nil {
+		t.Fatalf("unable to create lib: %v", err)
+	}
+	defer l.Free()
+
+	var nilOutline *Outline
+	if _, err := nilOutline.SVGPath(1); err != ErrInvalidOutline {
+		t.Errorf("Outline.SVGPath() error = %v, want %v", err, ErrInvalidOutline)
+	}
+
+	tests := []struct {
+		name  string
+		build func(b *OutlineBuilder)
+		scale float64
+		want  string
+	}{
+		{
+			name:  "empty",
+			build: func(b *OutlineBuilder) {},
+			scale: 1,
+			want:  "",
+		},
+		{
+			name: "triangle",
+			build: func(b *OutlineBuilder) {
+				b.MoveTo(Vector{X: 0, Y: 0})
+				b.LineTo(Vector{X: 64, Y: 0})
+				b.LineTo(Vector{X: 0, Y: 64})
+			},
+			scale: 1.0 / 64,
+			want:  "M0 0L1 0L0 -1L0 0Z",
+		},
+		{
+			name: "curves",
+			build: func(b *OutlineBuilder) {
+				b.MoveTo(Vector{X: 0, Y: 0})
+				b.QuadTo(Vector{X: 32, Y: 64}, Vector{X: 64, Y: 0})
+				b.CubicTo(Vector{X: 64, Y: -32}, Vector{X: 0, Y: -32}, Vector{X: 0, Y: 0})
+			},
+			scale: 1.0 / 64,
+			want:  "M0 0Q0.5 -1 1 0C1 0.5 0 0.5 0 0Z",
+		},
+		{
+			name: "contours",
+			build: func(b *OutlineBuilder) {
+				b.MoveTo(Vector{X: 0, Y: 0})
+				b.LineTo(Vector{X: 1, Y: 0})
+				b.LineTo(Vector{X: 1, Y: 1})
+				b.MoveTo(Vector{X: 2, Y: 2})
+				b.LineTo(Vector{X: 3, Y: 2})
+				b.LineTo(Vector{X: 3, Y: 3})
+			},
+			scale: 2,
+			want:  "M0 0L2 0L2 -2L0 0ZM4 -4L6 -4L6 -6L4 -4Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b OutlineBuilder
+			tt.build(&b)
+			o, err := b.Outline(l)
+			if err != nil {
+				t.Fatalf("unable to build outline: %v", err)
+			}
+			defer o.Free()
+
+			got, err := o.SVGPath(tt.scale)
+			if err != nil {
+				t.Fatalf("Outline.SVGPath() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Outline.SVGPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type svgDocument struct {
+	ViewBox string    `xml:"viewBox,attr"`
+	Paths   []svgPath `xml:"path"`
+}
+
+type svgPath struct {
+	D           string `xml:"d,attr"`
+	Fill        string `xml:"fill,attr"`
+	FillOpacity string `xml:"fill-opacity,attr"`
+}
+
+func TestFace_WriteSVG(t *testing.T) {
+	face, err := nilFace()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	if err := face.WriteSVG(&bytes.Buffer{}, nil, SVGOptions{}); err != ErrInvalidFaceHandle {
+		t.Errorf("Face.WriteSVG() error = %v, want %v", err, ErrInvalidFaceHandle)
+	}
+
+	face, err = goRegular()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set char size: %v", err)
+	}
+
+	if err := face.WriteSVG(nil, nil, SVGOptions{}); err != ErrInvalidArgument {
+		t.Errorf("Face.WriteSVG() error = %v, want %v", err, ErrInvalidArgument)
+	}
+
+	idx := face.CharIndex('A')
+	if err := face.LoadGlyph(idx, LoadNoBitmap); err != nil {
+		t.Fatalf("unable to load glyph: %v", err)
+	}
+	bbox := face.GlyphSlot().Outline.BBox()
+	path, err := face.GlyphSlot().Outline.SVGPath(1.0 / 64)
+	if err != nil {
+		t.Fatalf("unable to get path: %v", err)
+	}
+
+	viewBox := func(xMin, yMin, xMax, yMax Pos) string {
+		return fmt.Sprintf("%s %s %s %s",
+			svgNumber(float64(xMin)/64), svgNumber(-float64(yMax)/64),
+			svgNumber(float64(xMax-xMin)/64), svgNumber(float64(yMax-yMin)/64),
+		)
+	}
+
+	tests := []struct {
+		name   string
+		glyphs []SVGGlyph
+		opts   SVGOptions
+		want   svgDocument
+		// the number of paths, when their content is not checked.
+		numPaths int
+	}{
+		{
+			name: "empty",
+			want: svgDocument{ViewBox: "0 0 0 0"},
+		},
+		{
+			name:   "space",
+			glyphs: []SVGGlyph{{Index: face.CharIndex(' ')}},
+			want:   svgDocument{ViewBox: "0 0 0 0"},
+		},
+		{
+			name:   "glyph",
+			glyphs: []SVGGlyph{{Index: idx}},
+			want: svgDocument{
+				ViewBox: viewBox(bbox.XMin, bbox.YMin, bbox.XMax, bbox.YMax),
+				Paths:   []svgPath{{D: path, Fill: "#000000"}},
+			},
+		},
+		{
+			name:   "foreground",
+			glyphs: []SVGGlyph{{Index: idx}},
+			opts:   SVGOptions{Foreground: color.NRGBA{R: 0xff, G: 0x80, B: 0x00, A: 0x80}},
+			want: svgDocument{
+				ViewBox: viewBox(bbox.XMin, bbox.YMin, bbox.XMax, bbox.YMax),
+				Paths:   []svgPath{{D: path, Fill: "#ff8000", FillOpacity: "0.502"}},
+			},
+		},
+		{
+			name:   "run",
+			glyphs: []SVGGlyph{{Index: idx}, {Index: idx, X: 10 << 6, Y: -2 << 6}},
+			want: svgDocument{
+				ViewBox: viewBox(bbox.XMin, bbox.YMin-2<<6, bbox.XMax+10<<6, bbox.YMax),
+			},
+			numPaths: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := face.WriteSVG(&buf, tt.glyphs, tt.opts); err != nil {
+				t.Fatalf("Face.WriteSVG() error = %v", err)
+			}
+
+			var got svgDocument
+			if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("Face.WriteSVG() wrote invalid xml: %v\n%s", err, buf.String())
+			}
+
+			if got.ViewBox != tt.want.ViewBox {
+				t.Errorf("Face.WriteSVG() viewBox = %v, want %v", got.ViewBox, tt.want.ViewBox)
+			}
+			if tt.numPaths > 0 {
+				if len(got.Paths) != tt.numPaths {
+					t.Errorf("Face.WriteSVG() wrote %d paths, want %d", len(got.Paths), tt.numPaths)
+				}
+				return
+			}
+			if diff := diff(got.Paths, tt.want.Paths); diff != nil {
+				t.Errorf("Face.WriteSVG() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestFace_WriteSVG_colorLayers(t *testing.T) {
+	face, err := bungeeColorWin()
+	if err != nil {
+		t.Fatalf("unable to load face: %v", err)
+	}
+	defer face.Free()
+
+	if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+		t.Fatalf("unable to set char size: %v", err)
+	}
+
+	palette, err := face.SelectPalette(0, nil)
+	if err != nil {
+		t.Fatalf("unable to select palette: %v", err)
+	}
+
+	fill := func(c color.RGBA) string {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+
+	tests := []struct {
+		name  string
+		opts  SVGOptions
+		fills []string
+	}{
+		{name: "no layers", opts: SVGOptions{}, fills: []string{"#000000"}},
+		{name: "layers", opts: SVGOptions{ColorLayers: true, Palette: palette}, fills: []string{fill(palette[0]), fill(palette[1])}},
+		{name: "no palette", opts: SVGOptions{ColorLayers: true, Foreground: color.White}, fills: []string{"#ffffff", "#ffffff"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := face.WriteSVG(&buf, []SVGGlyph{{Index: 0x2b}}, tt.opts); err != nil {
+				t.Fatalf("Face.WriteSVG() error = %v", err)
+			}
+
+			var got svgDocument
+			if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("Face.WriteSVG() wrote invalid xml: %v\n%s", err, buf.String())
+			}
+
+			var fills []string
+			for _, p := range got.Paths {
+				fills = append(fills, p.Fill)
+			}
+			if diff := diff(fills, tt.fills); diff != nil {
+				t.Errorf("Face.WriteSVG() fills = %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package freetype2
+
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include FT_OUTLINE_H
+// #include FT_SYNTHESIS_H
+import "C"
+
+// SyntheticStyle is a list of bit flags used to request algorithmic styles
+// from a face that lacks them, see Face.SetSyntheticStyle.
+type SyntheticStyle uint
+
+const (
+	// SyntheticBold emboldens glyphs if the face is not bold.
+	SyntheticBold SyntheticStyle = 1 << iota
+	// SyntheticOblique slants glyphs if the face is not italic.
+	SyntheticOblique
+)
+
+func (x SyntheticStyle) String() string {
+	s := make([]byte, 0, 13) // len = sum of all the strings below.
+
+	if x&SyntheticBold == SyntheticBold {
+		s = append(s, []byte("Bold|")...)
+	}
+	if x&SyntheticOblique == SyntheticOblique {
+		s = append(s, []byte("Oblique|")...)
+	}
+
+	if len(s) == 0 {
+		return ""
+	}
+	return string(s[:len(s)-1]) // trim the leading |
+}
+
+// SetSyntheticStyle makes LoadGlyph and LoadChar embolden or slant every loaded
+// glyph using GlyphSlot.Embolden and GlyphSlot.Oblique, for each requested
+// style that the face does not provide natively according to its StyleFlag.
+//
+// This is useful when a family is only available in its regular style. If
+// LoadRender is used, the styles are applied before rendering.
+func (f *Face) SetSyntheticStyle(style SyntheticStyle) {
+	if f == nil || f.ptr == nil {
+		return
+	}
+
+	f.synthetic = style
+}
+
+// SyntheticStyle returns the styles that will be synthesized when loading
+// glyphs, taking into account the styles the face provides natively.
+func (f *Face) SyntheticStyle() SyntheticStyle {
+	if f == nil || f.ptr == nil {
+		return 0
+	}
+
+	style := f.synthetic
+	if f.HasStyle(StyleFlagBold) {
+		style &^= SyntheticBold
+	}
+	if f.HasStyle(StyleFlagItalic) {
+		style &^= SyntheticOblique
+	}
+	return style
+}
+
+// synthesize applies the face's synthetic styles to the glyph slot after it
+// has been loaded without LoadRender, then renders it if requested by flags.
+func (f *Face) synthesize(flags LoadFlag) error {
+	style := f.SyntheticStyle()
+	if style&SyntheticBold > 0 {
+		f.slot.Embolden()
+	}
+	if style&SyntheticOblique > 0 {
+		f.slot.Oblique()
+	}
+
+	if flags&LoadRender == 0 || f.slot.Format == GlyphFormatBitmap {
+		return nil
+	}
+
+	mode := RenderMode((flags >> 16) & 15)
+	if mode == RenderModeNormal && flags&LoadMonochrome > 0 {
+		mode = RenderModeMono
+	}
+	return f.slot.RenderGlyph(mode)
+}
+
+// Embolden emboldens the glyph loaded in the slot, be it an outline or a
+// bitmap, by an amount proportional to the face's size. Metrics and Advance
+// are updated accordingly, so that emboldened glyphs do not overlap.
+//
+// Bitmap slots are made to own their bitmap before it gets modified.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_glyphslot_embolden
+func (g *GlyphSlot) Embolden() {
+	if g == nil || g.ptr == nil {
+		return
+	}
+
+	C.FT_GlyphSlot_Embolden(g.ptr)
+	g.reload()
+}
+
+// Oblique slants the outline loaded in the slot by about 12 degrees, keeping
+// the baseline fixed. The Advance is unchanged while the horizontal bearing and
+// width of Metrics are updated to the slanted outline's control box.
+//
+// FreeType can only slant outlines, bitmap slots are left untouched.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-base_interface.html#ft_glyphslot_oblique
+func (g *GlyphSlot) Oblique() {
+	if g == nil || g.ptr == nil {
+		return
+	}
+
+	if g.ptr.format != C.FT_GLYPH_FORMAT_OUTLINE {
+		return
+	}
+
+	C.FT_GlyphSlot_Oblique(g.ptr)
+	if g.ptr.outline.n_points > 0 {
+		var cbox C.FT_BBox
+		C.FT_Outline_Get_CBox(&g.ptr.outline, &cbox)
+		g.ptr.metrics.width = cbox.xMax - cbox.xMin
+		g.ptr.metrics.horiBearingX = cbox.xMin
+	}
+	g.reload()
+}
@@ -0,0 +1,279 @@
+package freetype2
+
+import (
+	"testing"
+)
+
+func TestSyntheticStyle_String(t *testing.T) {
+	tests := []struct {
+		name string
+		x    SyntheticStyle
+		want string
+	}{
+		{name: "0", x: 0, want: ""},
+		{name: "bold", x: SyntheticBold, want: "Bold"},
+		{name: "oblique", x: SyntheticOblique, want: "Oblique"},
+		{name: "all", x: SyntheticBold | SyntheticOblique, want: "Bold|Oblique"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("SyntheticStyle.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGlyphSlot_Embolden(t *testing.T) {
+	tests := []struct {
+		name        string
+		face        func() (testface, error)
+		char        rune
+		flags       LoadFlag
+		wantMetrics GlyphMetrics
+		wantAdvance Vector26_6
+		wantWidth   int
+	}{
+		{
+			name:  "outline",
+			face:  goRegular,
+			char:  'H',
+			flags: LoadDefault,
+			wantMetrics: GlyphMetrics{
+				Width: 549, Height: 741, HoriBearingX: 64, HoriBearingY: 741, HoriAdvance: 677,
+				VertBearingX: -256, VertBearingY: 64, VertAdvance: 933,
+			},
+			wantAdvance: Vector26_6{X: 677},
+			wantWidth:   0,
+		},
+		{
+			name:  "rendered",
+			face:  goRegular,
+			char:  'H',
+			flags: LoadRender,
+			wantMetrics: GlyphMetrics{
+				Width: 576, Height: 704, HoriBearingX: 64, HoriBearingY: 704, HoriAdvance: 704,
+				VertBearingX: -256, VertBearingY: 64, VertAdvance: 896,
+			},
+			wantAdvance: Vector26_6{X: 704},
+			wantWidth:   9,
+		},
+		{
+			name:  "type1",
+			face:  nimbusMono,
+			char:  'H',
+			flags: LoadDefault,
+			wantMetrics: GlyphMetrics{
+				Width: 549, Height: 549, HoriBearingX: 0, HoriBearingY: 549, HoriAdvance: 549,
+				VertBearingX: 0, VertBearingY: 0, VertAdvance: 37,
+			},
+			wantAdvance: Vector26_6{X: 549},
+			wantWidth:   0,
+		},
+		{
+			name:  "bitmap",
+			face:  gohuBdf,
+			char:  'H',
+			flags: LoadDefault,
+			wantMetrics: GlyphMetrics{
+				Width: 384, Height: 512, HoriBearingX: 0, HoriBearingY: 512, HoriAdvance: 448,
+				VertBearingX: -192, VertBearingY: 352, VertAdvance: 704,
+			},
+			wantAdvance: Vector26_6{X: 448},
+			wantWidth:   6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to open font: %v", err)
+			}
+			defer face.Free()
+
+			if face.HasFlag(FaceFlagFixedSizes) {
+				err = face.SelectSize(0)
+			} else {
+				err = face.SetCharSize(14<<6, 14<<6, 72, 72)
+			}
+			if err != nil {
+				t.Fatalf("unable to set size: %v", err)
+			}
+
+			if err := face.LoadChar(tt.char, tt.flags); err != nil {
+				t.Fatalf("unable to load glyph: %v", err)
+			}
+
+			slot := face.GlyphSlot()
+			slot.Embolden()
+
+			if diff := diff(slot.Metrics, tt.wantMetrics); diff != nil {
+				t.Errorf("GlyphSlot.Embolden() metrics = %v", diff)
+			}
+			if slot.Advance != tt.wantAdvance {
+				t.Errorf("GlyphSlot.Embolden() advance = %v, want %v", slot.Advance, tt.wantAdvance)
+			}
+			if slot.Format == GlyphFormatBitmap && slot.Bitmap.Width != tt.wantWidth {
+				t.Errorf("GlyphSlot.Embolden() bitmap width = %v, want %v", slot.Bitmap.Width, tt.wantWidth)
+			}
+		})
+	}
+
+	var nilSlot *GlyphSlot
+	nilSlot.Embolden()
+}
+
+func TestGlyphSlot_Oblique(t *testing.T) {
+	tests := []struct {
+		name        string
+		face        func() (testface, error)
+		char        rune
+		wantMetrics GlyphMetrics
+		wantAdvance Vector26_6
+	}{
+		{
+			name: "outline",
+			face: goRegular,
+			char: 'H',
+			wantMetrics: GlyphMetrics{
+				Width: 652, Height: 704, HoriBearingX: 72, HoriBearingY: 704, HoriAdvance: 640,
+				VertBearingX: -256, VertBearingY: 64, VertAdvance: 896,
+			},
+			wantAdvance: Vector26_6{X: 640},
+		},
+		{
+			name: "bitmap",
+			face: gohuBdf,
+			char: 'H',
+			wantMetrics: GlyphMetrics{
+				Width: 320, Height: 512, HoriBearingX: 0, HoriBearingY: 512, HoriAdvance: 384,
+				VertBearingX: -192, VertBearingY: 352, VertAdvance: 704,
+			},
+			wantAdvance: Vector26_6{X: 384},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to open font: %v", err)
+			}
+			defer face.Free()
+
+			if face.HasFlag(FaceFlagFixedSizes) {
+				err = face.SelectSize(0)
+			} else {
+				err = face.SetCharSize(14<<6, 14<<6, 72, 72)
+			}
+			if err != nil {
+				t.Fatalf("unable to set size: %v", err)
+			}
+
+			if err := face.LoadChar(tt.char, LoadDefault); err != nil {
+				t.Fatalf("unable to load glyph: %v", err)
+			}
+
+			slot := face.GlyphSlot()
+			slot.Oblique()
+
+			if diff := diff(slot.Metrics, tt.wantMetrics); diff != nil {
+				t.Errorf("GlyphSlot.Oblique() metrics = %v", diff)
+			}
+			if slot.Advance != tt.wantAdvance {
+				t.Errorf("GlyphSlot.Oblique() advance = %v, want %v", slot.Advance, tt.wantAdvance)
+			}
+			if slot.Format == GlyphFormatOutline {
+				if got := slot.Outline.CBox(); got.XMin != Pos(tt.wantMetrics.HoriBearingX) {
+					t.Errorf("GlyphSlot.Oblique() outline cbox = %v, want XMin %v", got, tt.wantMetrics.HoriBearingX)
+				}
+			}
+		})
+	}
+
+	var nilSlot *GlyphSlot
+	nilSlot.Oblique()
+}
+
+func TestFace_SetSyntheticStyle(t *testing.T) {
+	tests := []struct {
+		name        string
+		face        func() (testface, error)
+		style       SyntheticStyle
+		flags       LoadFlag
+		wantStyle   SyntheticStyle
+		wantAdvance Vector26_6
+		wantFormat  GlyphFormat
+		wantWidth   int
+	}{
+		{name: "nil face", face: nilFace, style: SyntheticBold, wantStyle: 0},
+		{
+			name: "none", face: goRegular, style: 0, flags: LoadRender,
+			wantStyle: 0, wantAdvance: Vector26_6{X: 640}, wantFormat: GlyphFormatBitmap, wantWidth: 8,
+		},
+		{
+			name: "bold", face: goRegular, style: SyntheticBold, flags: LoadDefault,
+			wantStyle: SyntheticBold, wantAdvance: Vector26_6{X: 677}, wantFormat: GlyphFormatOutline,
+		},
+		{
+			name: "bold render", face: goRegular, style: SyntheticBold, flags: LoadRender,
+			wantStyle: SyntheticBold, wantAdvance: Vector26_6{X: 677}, wantFormat: GlyphFormatBitmap, wantWidth: 9,
+		},
+		{
+			name: "oblique render", face: goRegular, style: SyntheticOblique, flags: LoadRender,
+			wantStyle: SyntheticOblique, wantAdvance: Vector26_6{X: 640}, wantFormat: GlyphFormatBitmap, wantWidth: 11,
+		},
+		{
+			name: "real bold", face: goBold, style: SyntheticBold | SyntheticOblique, flags: LoadRender,
+			wantStyle: SyntheticOblique, wantAdvance: Vector26_6{X: 640}, wantFormat: GlyphFormatBitmap, wantWidth: 11,
+		},
+		{
+			name: "real italic", face: goItalic, style: SyntheticOblique, flags: LoadRender,
+			wantStyle: 0, wantAdvance: Vector26_6{X: 640}, wantFormat: GlyphFormatBitmap, wantWidth: 11,
+		},
+		{
+			name: "type1", face: nimbusMono, style: SyntheticBold | SyntheticOblique, flags: LoadRender,
+			wantStyle: SyntheticBold | SyntheticOblique, wantAdvance: Vector26_6{X: 549}, wantFormat: GlyphFormatBitmap, wantWidth: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to open font: %v", err)
+			}
+			defer face.Free()
+
+			face.SetSyntheticStyle(tt.style)
+			if got := face.SyntheticStyle(); got != tt.wantStyle {
+				t.Errorf("Face.SyntheticStyle() = %v, want %v", got, tt.wantStyle)
+			}
+			if face.Face == nil {
+				return
+			}
+
+			if err := face.SetCharSize(14<<6, 14<<6, 72, 72); err != nil {
+				t.Fatalf("unable to set size: %v", err)
+			}
+
+			for _, load := range []func() error{
+				func() error { return face.LoadChar('H', tt.flags) },
+				func() error { return face.LoadGlyph(face.CharIndex('H'), tt.flags) },
+			} {
+				if err := load(); err != nil {
+					t.Fatalf("unable to load glyph: %v", err)
+				}
+
+				slot := face.GlyphSlot()
+				if slot.Advance != tt.wantAdvance {
+					t.Errorf("advance = %v, want %v", slot.Advance, tt.wantAdvance)
+				}
+				if slot.Format != tt.wantFormat {
+					t.Errorf("format = %v, want %v", slot.Format, tt.wantFormat)
+				}
+				if slot.Format == GlyphFormatBitmap && slot.Bitmap.Width != tt.wantWidth {
+					t.Errorf("bitmap width = %v, want %v", slot.Bitmap.Width, tt.wantWidth)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package freetype2
+
+// #include <stdlib.h>
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include FT_SYSTEM_H
+//
+// unsigned long FaceStreamReadFunc(FT_Stream stream, unsigned long offset, unsigned char* buffer, unsigned long count);
+// void FaceStreamCloseFunc(FT_Stream stream);
+import "C"
+
+import (
+	"io"
+	"sync"
+	"unsafe"
+)
+
+// faceStream is the Go side of an FT_Stream created by Library.OpenFace.
+type faceStream struct {
+	r    io.ReaderAt
+	size int64
+}
+
+// read implements FT_Stream_IoFunc. A count of 0 is a seek request, in which
+// case 0 must be returned on success.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-system_interface.html#ft_stream_iofunc
+func (s *faceStream) read(offset int64, buf []byte) int {
+	if len(buf) == 0 {
+		if offset > s.size {
+			return 1
+		}
+		return 0
+	}
+
+	if offset >= s.size {
+		return 0
+	}
+	if rem := s.size - offset; int64(len(buf)) > rem {
+		buf = buf[:rem]
+	}
+
+	n, err := s.r.ReadAt(buf, offset)
+	if err != nil && err != io.EOF {
+		return 0
+	}
+	return n
+}
+
+type faceStreamTable struct {
+	sync.Mutex
+	table map[C.FT_Stream]*faceStream
+}
+
+// acquire allocates a stream record on the C heap and binds it to r. The record
+// is released by FaceStreamCloseFunc, which FreeType calls once it is done with
+// the stream.
+func (m *faceStreamTable) acquire(r io.ReaderAt, size int64) C.FT_Stream {
+	stream := (C.FT_Stream)(C.calloc(1, C.sizeof_FT_StreamRec))
+	stream.size = C.ulong(size)
+	stream.read = (*[0]byte)(C.FaceStreamReadFunc)
+	stream.close = (*[0]byte)(C.FaceStreamCloseFunc)
+
+	m.Lock()
+	m.table[stream] = &faceStream{r: r, size: size}
+	m.Unlock()
+
+	return stream
+}
+
+func (m *faceStreamTable) valueOf(stream C.FT_Stream) *faceStream {
+	m.Lock()
+	v := m.table[stream]
+	m.Unlock()
+	return v
+}
+
+func (m *faceStreamTable) release(stream C.FT_Stream) {
+	m.Lock()
+	delete(m.table, stream)
+	m.Unlock()
+
+	free(unsafe.Pointer(stream))
+}
+
+func (m *faceStreamTable) len() int {
+	m.Lock()
+	n := len(m.table)
+	m.Unlock()
+	return n
+}
+
+var faceStreams = &faceStreamTable{table: make(map[C.FT_Stream]*faceStream)}
@@ -0,0 +1,30 @@
+package freetype2
+
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include FT_SYSTEM_H
+import "C"
+import "unsafe"
+
+//export FaceStreamReadFunc
+func FaceStreamReadFunc(stream C.FT_Stream, offset C.ulong, buffer *C.uchar, count C.ulong) C.ulong {
+	s := faceStreams.valueOf(stream)
+	if s == nil {
+		if count == 0 {
+			return 1
+		}
+		return 0
+	}
+
+	var buf []byte
+	if count > 0 {
+		buf = (*[1 << 30]byte)(unsafe.Pointer(buffer))[:count:count]
+	}
+
+	return C.ulong(s.read(int64(offset), buf))
+}
+
+//export FaceStreamCloseFunc
+func FaceStreamCloseFunc(stream C.FT_Stream) {
+	faceStreams.release(stream)
+}
@@ -1,8 +1,11 @@
 package freetype2
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"path/filepath"
 
 	"github.com/flga/freetype2/fixed"
@@ -77,6 +80,9 @@ func notoSansJpReg() (testface, error) {
 func notoSansJpBold() (testface, error) {
 	return openFace(testdata("noto sans jp", "NotoSansJP-Bold.otf"))
 }
+func notoSansJpThin() (testface, error) {
+	return openFace(testdata("noto sans jp", "NotoSansJP-Thin.otf"))
+}
 func arimoRegular() (testface, error) {
 	return openFace(testdata("arimo", "Arimo-Regular.ttf"))
 }
@@ -108,6 +114,57 @@ func faceFromPath(p string) func() (testface, error) {
 	return func() (testface, error) { return openFace(testdata(p)) }
 }
 
+// goCollection builds a font collection out of Go-Regular and Go-Bold, in that
+// order, and opens the face at the given index.
+func goCollection(index int) func() (testface, error) {
+	return func() (testface, error) {
+		var fonts [][]byte
+		for _, name := range []string{"Go-Regular.ttf", "Go-Bold.ttf"} {
+			data, err := ioutil.ReadFile(testdata("go", name))
+			if err != nil {
+				return testface{}, fmt.Errorf("unable to read font: %v", err)
+			}
+			fonts = append(fonts, data)
+		}
+
+		l, err := NewLibrary()
+		if err != nil {
+			return testface{}, fmt.Errorf("unable to initialize library: %v", err)
+		}
+		face, err := l.NewFace(bytes.NewReader(makeCollection(fonts...)), index, 0)
+		if err != nil {
+			l.Free()
+			return testface{}, fmt.Errorf("unable to open font: %s", err)
+		}
+		return testface{face, l}, nil
+	}
+}
+
+// makeCollection concatenates the given sfnt fonts into a TrueType collection,
+// relocating their table directories.
+func makeCollection(fonts ...[]byte) []byte {
+	header := 12 + 4*len(fonts)
+	buf := make([]byte, header)
+	copy(buf, "ttcf")
+	binary.BigEndian.PutUint32(buf[4:], 0x00010000)
+	binary.BigEndian.PutUint32(buf[8:], uint32(len(fonts)))
+
+	for i, font := range fonts {
+		base := len(buf)
+		binary.BigEndian.PutUint32(buf[12+4*i:], uint32(base))
+
+		font = append([]byte(nil), font...)
+		numTables := int(binary.BigEndian.Uint16(font[4:]))
+		for j := 0; j < numTables; j++ {
+			rec := font[12+16*j:]
+			binary.BigEndian.PutUint32(rec[8:], binary.BigEndian.Uint32(rec[8:])+uint32(base))
+		}
+		buf = append(buf, font...)
+	}
+
+	return buf
+}
+
 type testglyph struct {
 	Glyph
 	f testface
@@ -0,0 +1,439 @@
+package freetype2
+
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include FT_TRUETYPE_TABLES_H
+import "C"
+import (
+	"encoding/binary"
+	"time"
+	"unsafe"
+
+	"github.com/flga/freetype2/2.10.1/truetype"
+	"github.com/flga/freetype2/fixed"
+)
+
+// sfntEpoch is the number of seconds between the SFNT epoch (1904-01-01) and
+// the unix epoch.
+const sfntEpoch = 2082844800
+
+func sfntTime(v [2]C.FT_ULong) time.Time {
+	secs := int64(v[0])<<32 | int64(v[1]&0xFFFFFFFF)
+	return time.Unix(secs-sfntEpoch, 0).UTC()
+}
+
+func (f *Face) sfntTable(tag C.FT_Sfnt_Tag) (unsafe.Pointer, error) {
+	if f == nil || f.ptr == nil {
+		return nil, ErrInvalidFaceHandle
+	}
+
+	ptr := C.FT_Get_Sfnt_Table(f.ptr, tag)
+	if ptr == nil {
+		return nil, ErrTableMissing
+	}
+
+	return ptr, nil
+}
+
+// Header returns a copy of the face's ‘head’ table.
+//
+// It returns ErrTableMissing if the face is not SFNT-based or if the table
+// was not loaded from the file.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-truetype_tables.html#ft_get_sfnt_table
+func (f *Face) Header() (truetype.Header, error) {
+	ptr, err := f.sfntTable(C.FT_SFNT_HEAD)
+	if err != nil {
+		return truetype.Header{}, err
+	}
+
+	h := (*C.TT_Header)(ptr)
+	return truetype.Header{
+		TableVersion:     fixed.Int16_16(h.Table_Version),
+		FontRevision:     fixed.Int16_16(h.Font_Revision),
+		CheckSumAdjust:   int32(h.CheckSum_Adjust),
+		MagicNumber:      int32(h.Magic_Number),
+		Flags:            uint16(h.Flags),
+		UnitsPerEM:       uint16(h.Units_Per_EM),
+		Created:          sfntTime(h.Created),
+		Modified:         sfntTime(h.Modified),
+		XMin:             int16(h.xMin),
+		YMin:             int16(h.yMin),
+		XMax:             int16(h.xMax),
+		YMax:             int16(h.yMax),
+		MacStyle:         uint16(h.Mac_Style),
+		LowestRecPPEM:    uint16(h.Lowest_Rec_PPEM),
+		FontDirection:    int16(h.Font_Direction),
+		IndexToLocFormat: int16(h.Index_To_Loc_Format),
+		GlyphDataFormat:  int16(h.Glyph_Data_Format),
+	}, nil
+}
+
+// HoriHeader returns a copy of the face's ‘hhea’ table.
+//
+// It returns ErrTableMissing if the face is not SFNT-based or if the table
+// was not loaded from the file.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-truetype_tables.html#ft_get_sfnt_table
+func (f *Face) HoriHeader() (truetype.HoriHeader, error) {
+	ptr, err := f.sfntTable(C.FT_SFNT_HHEA)
+	if err != nil {
+		return truetype.HoriHeader{}, err
+	}
+
+	h := (*C.TT_HoriHeader)(ptr)
+	return truetype.HoriHeader{
+		Version:             fixed.Int16_16(h.Version),
+		Ascender:            int16(h.Ascender),
+		Descender:           int16(h.Descender),
+		LineGap:             int16(h.Line_Gap),
+		AdvanceWidthMax:     uint16(h.advance_Width_Max),
+		MinLeftSideBearing:  int16(h.min_Left_Side_Bearing),
+		MinRightSideBearing: int16(h.min_Right_Side_Bearing),
+		XMaxExtent:          int16(h.xMax_Extent),
+		CaretSlopeRise:      int16(h.caret_Slope_Rise),
+		CaretSlopeRun:       int16(h.caret_Slope_Run),
+		CaretOffset:         int16(h.caret_Offset),
+		MetricDataFormat:    int16(h.metric_Data_Format),
+		NumberOfHMetrics:    uint16(h.number_Of_HMetrics),
+	}, nil
+}
+
+// VertHeader returns a copy of the face's ‘vhea’ table.
+//
+// It returns ErrTableMissing if the face is not SFNT-based or if it has no
+// vertical metrics.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-truetype_tables.html#ft_get_sfnt_table
+func (f *Face) VertHeader() (truetype.VertHeader, error) {
+	ptr, err := f.sfntTable(C.FT_SFNT_VHEA)
+	if err != nil {
+		return truetype.VertHeader{}, err
+	}
+
+	h := (*C.TT_VertHeader)(ptr)
+	return truetype.VertHeader{
+		Version:              fixed.Int16_16(h.Version),
+		Ascender:             int16(h.Ascender),
+		Descender:            int16(h.Descender),
+		LineGap:              int16(h.Line_Gap),
+		AdvanceHeightMax:     uint16(h.advance_Height_Max),
+		MinTopSideBearing:    int16(h.min_Top_Side_Bearing),
+		MinBottomSideBearing: int16(h.min_Bottom_Side_Bearing),
+		YMaxExtent:           int16(h.yMax_Extent),
+		CaretSlopeRise:       int16(h.caret_Slope_Rise),
+		CaretSlopeRun:        int16(h.caret_Slope_Run),
+		CaretOffset:          int16(h.caret_Offset),
+		MetricDataFormat:     int16(h.metric_Data_Format),
+		NumberOfVMetrics:     uint16(h.number_Of_VMetrics),
+	}, nil
+}
+
+// OS2 returns a copy of the face's ‘OS/2’ table.
+//
+// It returns ErrTableMissing if the face is not SFNT-based or if the table
+// is missing, like in old Mac fonts.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-truetype_tables.html#ft_get_sfnt_table
+func (f *Face) OS2() (truetype.OS2, error) {
+	ptr, err := f.sfntTable(C.FT_SFNT_OS2)
+	if err != nil {
+		return truetype.OS2{}, err
+	}
+
+	t := (*C.TT_OS2)(ptr)
+	ret := truetype.OS2{
+		Version:                 uint16(t.version),
+		XAvgCharWidth:           int16(t.xAvgCharWidth),
+		UsWeightClass:           uint16(t.usWeightClass),
+		UsWidthClass:            uint16(t.usWidthClass),
+		FsType:                  uint16(t.fsType),
+		YSubscriptXSize:         int16(t.ySubscriptXSize),
+		YSubscriptYSize:         int16(t.ySubscriptYSize),
+		YSubscriptXOffset:       int16(t.ySubscriptXOffset),
+		YSubscriptYOffset:       int16(t.ySubscriptYOffset),
+		YSuperscriptXSize:       int16(t.ySuperscriptXSize),
+		YSuperscriptYSize:       int16(t.ySuperscriptYSize),
+		YSuperscriptXOffset:     int16(t.ySuperscriptXOffset),
+		YSuperscriptYOffset:     int16(t.ySuperscriptYOffset),
+		YStrikeoutSize:          int16(t.yStrikeoutSize),
+		YStrikeoutPosition:      int16(t.yStrikeoutPosition),
+		SFamilyClass:            int16(t.sFamilyClass),
+		UlUnicodeRange1:         truetype.UCRMask(t.ulUnicodeRange1),
+		UlUnicodeRange2:         truetype.UCRMask(t.ulUnicodeRange2),
+		UlUnicodeRange3:         truetype.UCRMask(t.ulUnicodeRange3),
+		UlUnicodeRange4:         truetype.UCRMask(t.ulUnicodeRange4),
+		FsSelection:             uint16(t.fsSelection),
+		UsFirstCharIndex:        uint16(t.usFirstCharIndex),
+		UsLastCharIndex:         uint16(t.usLastCharIndex),
+		STypoAscender:           int16(t.sTypoAscender),
+		STypoDescender:          int16(t.sTypoDescender),
+		STypoLineGap:            int16(t.sTypoLineGap),
+		UsWinAscent:             uint16(t.usWinAscent),
+		UsWinDescent:            uint16(t.usWinDescent),
+		UlCodePageRange1:        uint32(t.ulCodePageRange1),
+		UlCodePageRange2:        uint32(t.ulCodePageRange2),
+		SxHeight:                int16(t.sxHeight),
+		SCapHeight:              int16(t.sCapHeight),
+		UsDefaultChar:           uint16(t.usDefaultChar),
+		UsBreakChar:             uint16(t.usBreakChar),
+		UsMaxContext:            uint16(t.usMaxContext),
+		UsLowerOpticalPointSize: uint16(t.usLowerOpticalPointSize),
+		UsUpperOpticalPointSize: uint16(t.usUpperOpticalPointSize),
+	}
+	for i, v := range t.panose {
+		ret.Panose[i] = byte(v)
+	}
+	for i, v := range t.achVendID {
+		ret.AchVendID[i] = int8(v)
+	}
+
+	return ret, nil
+}
+
+// Postscript returns a copy of the face's ‘post’ table.
+//
+// It returns ErrTableMissing if the face is not SFNT-based or if the table
+// was not loaded from the file.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-truetype_tables.html#ft_get_sfnt_table
+func (f *Face) Postscript() (truetype.Postscript, error) {
+	ptr, err := f.sfntTable(C.FT_SFNT_POST)
+	if err != nil {
+		return truetype.Postscript{}, err
+	}
+
+	t := (*C.TT_Postscript)(ptr)
+	return truetype.Postscript{
+		FormatType:         fixed.Int16_16(t.FormatType),
+		ItalicAngle:        fixed.Int16_16(t.italicAngle),
+		UnderlinePosition:  int16(t.underlinePosition),
+		UnderlineThickness: int16(t.underlineThickness),
+		IsFixedPitch:       uint32(t.isFixedPitch),
+		MinMemType42:       uint32(t.minMemType42),
+		MaxMemType42:       uint32(t.maxMemType42),
+		MinMemType1:        uint32(t.minMemType1),
+		MaxMemType1:        uint32(t.maxMemType1),
+	}, nil
+}
+
+// PCLT returns a copy of the face's ‘PCLT’ table.
+//
+// It returns ErrTableMissing if the face is not SFNT-based or if it has no
+// ‘PCLT’ table, which is the case for most modern fonts.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-truetype_tables.html#ft_get_sfnt_table
+func (f *Face) PCLT() (truetype.PCLT, error) {
+	ptr, err := f.sfntTable(C.FT_SFNT_PCLT)
+	if err != nil {
+		return truetype.PCLT{}, err
+	}
+
+	t := (*C.TT_PCLT)(ptr)
+	ret := truetype.PCLT{
+		Version:      fixed.Int16_16(t.Version),
+		FontNumber:   uint32(t.FontNumber),
+		Pitch:        uint16(t.Pitch),
+		XHeight:      uint16(t.xHeight),
+		Style:        uint16(t.Style),
+		TypeFamily:   uint16(t.TypeFamily),
+		CapHeight:    uint16(t.CapHeight),
+		SymbolSet:    uint16(t.SymbolSet),
+		StrokeWeight: int8(t.StrokeWeight),
+		WidthType:    int8(t.WidthType),
+		SerifStyle:   byte(t.SerifStyle),
+	}
+	for i, v := range t.TypeFace {
+		ret.TypeFace[i] = int8(v)
+	}
+	for i, v := range t.CharacterComplement {
+		ret.CharacterComplement[i] = int8(v)
+	}
+	for i, v := range t.FileName {
+		ret.FileName[i] = int8(v)
+	}
+
+	return ret, nil
+}
+
+// MaxProfile returns a copy of the face's ‘maxp’ table.
+//
+// It returns ErrTableMissing if the face is not SFNT-based or if the table
+// was not loaded from the file.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-truetype_tables.html#ft_get_sfnt_table
+func (f *Face) MaxProfile() (truetype.MaxProfile, error) {
+	ptr, err := f.sfntTable(C.FT_SFNT_MAXP)
+	if err != nil {
+		return truetype.MaxProfile{}, err
+	}
+
+	t := (*C.TT_MaxProfile)(ptr)
+	return truetype.MaxProfile{
+		Version:               fixed.Int16_16(t.version),
+		NumGlyphs:             uint16(t.numGlyphs),
+		MaxPoints:             uint16(t.maxPoints),
+		MaxContours:           uint16(t.maxContours),
+		MaxCompositePoints:    uint16(t.maxCompositePoints),
+		MaxCompositeContours:  uint16(t.maxCompositeContours),
+		MaxZones:              uint16(t.maxZones),
+		MaxTwilightPoints:     uint16(t.maxTwilightPoints),
+		MaxStorage:            uint16(t.maxStorage),
+		MaxFunctionDefs:       uint16(t.maxFunctionDefs),
+		MaxInstructionDefs:    uint16(t.maxInstructionDefs),
+		MaxStackElements:      uint16(t.maxStackElements),
+		MaxSizeOfInstructions: uint16(t.maxSizeOfInstructions),
+		MaxComponentElements:  uint16(t.maxComponentElements),
+		MaxComponentDepth:     uint16(t.maxComponentDepth),
+	}, nil
+}
+
+// SfntTable describes an entry of the face's SFNT table directory.
+type SfntTable struct {
+	// The table's tag.
+	Tag Tag
+	// The table's offset from the start of the font file. For WOFF and WOFF2
+	// fonts this is an offset into the decompressed SFNT data.
+	Offset uint32
+	// The table's length in bytes.
+	Length uint32
+}
+
+// LoadSfntTable returns length bytes of the SFNT table identified by tag,
+// starting at offset. If length is 0 the rest of the table is returned.
+//
+// If tag is 0, the bytes are read from the font file itself (for WOFF and
+// WOFF2 fonts, from the decompressed SFNT data), offsets being relative to its
+// start.
+//
+// It returns ErrTableMissing if the face is not SFNT-based or if it does not
+// contain the table, and ErrInvalidArgument if the requested range is out of
+// bounds.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-truetype_tables.html#ft_load_sfnt_table
+func (f *Face) LoadSfntTable(tag Tag, offset, length int) ([]byte, error) {
+	if f == nil || f.ptr == nil {
+		return nil, ErrInvalidFaceHandle
+	}
+	if !f.HasFlag(FaceFlagSfnt) {
+		return nil, ErrTableMissing
+	}
+	if offset < 0 || length < 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	var size C.FT_ULong
+	if err := getErr(C.FT_Load_Sfnt_Table(f.ptr, C.FT_ULong(tag), 0, nil, &size)); err != nil {
+		return nil, err
+	}
+
+	if offset > int(size) {
+		return nil, ErrInvalidArgument
+	}
+	if length == 0 {
+		length = int(size) - offset
+	}
+	if offset+length > int(size) {
+		return nil, ErrInvalidArgument
+	}
+	if length == 0 {
+		return []byte{}, nil
+	}
+
+	buf := make([]byte, length)
+	n := C.FT_ULong(length)
+	if err := getErr(C.FT_Load_Sfnt_Table(f.ptr, C.FT_ULong(tag), C.FT_Long(offset), (*C.FT_Byte)(unsafe.Pointer(&buf[0])), &n)); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// SfntTables returns the face's SFNT table directory, in the order it appears
+// in the font. Tables with a length of zero are treated as missing by FreeType
+// and are not reported.
+//
+// For font collections, the directory of the face's subfont is returned.
+//
+// It returns ErrTableMissing if the face is not SFNT-based.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-truetype_tables.html#ft_sfnt_table_info
+func (f *Face) SfntTables() ([]SfntTable, error) {
+	if f == nil || f.ptr == nil {
+		return nil, ErrInvalidFaceHandle
+	}
+	if !f.HasFlag(FaceFlagSfnt) {
+		return nil, ErrTableMissing
+	}
+
+	var count C.FT_ULong
+	if err := getErr(C.FT_Sfnt_Table_Info(f.ptr, 0, nil, &count)); err != nil {
+		return nil, err
+	}
+
+	offsets, err := f.sfntTableOffsets()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]SfntTable, 0, int(count))
+	for i := C.FT_UInt(0); i < C.FT_UInt(count); i++ {
+		var tag, length C.FT_ULong
+		if err := getErr(C.FT_Sfnt_Table_Info(f.ptr, i, &tag, &length)); err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, SfntTable{
+			Tag:    Tag(tag),
+			Offset: offsets[Tag(tag)],
+			Length: uint32(length),
+		})
+	}
+
+	return ret, nil
+}
+
+// sfntTableOffsets reads the table directory of the face's subfont and returns
+// the offset of each table, keyed by tag.
+func (f *Face) sfntTableOffsets() (map[Tag]uint32, error) {
+	dirOffset := 0
+
+	header, err := f.LoadSfntTable(0, 0, 12)
+	if err != nil {
+		return nil, err
+	}
+	if Tag(binary.BigEndian.Uint32(header)) == MakeTag("ttcf") {
+		numFonts := int(binary.BigEndian.Uint32(header[8:]))
+		if f.Index() >= numFonts {
+			return nil, ErrInvalidTable
+		}
+
+		offset, err := f.LoadSfntTable(0, 12+4*f.Index(), 4)
+		if err != nil {
+			return nil, err
+		}
+		dirOffset = int(binary.BigEndian.Uint32(offset))
+
+		if header, err = f.LoadSfntTable(0, dirOffset, 12); err != nil {
+			return nil, err
+		}
+	}
+
+	numTables := int(binary.BigEndian.Uint16(header[4:]))
+	if numTables == 0 {
+		return map[Tag]uint32{}, nil
+	}
+
+	records, err := f.LoadSfntTable(0, dirOffset+12, 16*numTables)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[Tag]uint32, numTables)
+	for i := 0; i < numTables; i++ {
+		rec := records[16*i:]
+		ret[Tag(binary.BigEndian.Uint32(rec))] = binary.BigEndian.Uint32(rec[8:])
+	}
+
+	return ret, nil
+}
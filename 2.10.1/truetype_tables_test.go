@@ -0,0 +1,497 @@
+package freetype2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flga/freetype2/2.10.1/truetype"
+)
+
+func TestFace_Header(t *testing.T) {
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		want    truetype.Header
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, want: truetype.Header{}, wantErr: ErrInvalidFaceHandle},
+		{name: "nimbusMono", face: nimbusMono, want: truetype.Header{}, wantErr: ErrTableMissing},
+		{
+			name: "goRegular",
+			face: goRegular,
+			want: truetype.Header{
+				TableVersion:     65536,
+				FontRevision:     131596,
+				CheckSumAdjust:   -1497249966,
+				MagicNumber:      1594834165,
+				Flags:            0xf,
+				UnitsPerEM:       2048,
+				Created:          time.Date(2016, time.November, 10, 0, 0, 0, 0, time.UTC),
+				Modified:         time.Date(2017, time.March, 23, 22, 46, 6, 0, time.UTC),
+				XMin:             -440,
+				YMin:             -543,
+				XMax:             2160,
+				YMax:             2118,
+				MacStyle:         0,
+				LowestRecPPEM:    9,
+				FontDirection:    2,
+				IndexToLocFormat: 0,
+				GlyphDataFormat:  0,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.Header()
+			if err != tt.wantErr {
+				t.Errorf("Face.Header() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Face.Header() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestFace_HoriHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		want    truetype.HoriHeader
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, want: truetype.HoriHeader{}, wantErr: ErrInvalidFaceHandle},
+		{name: "nimbusMono", face: nimbusMono, want: truetype.HoriHeader{}, wantErr: ErrTableMissing},
+		{
+			name: "goRegular",
+			face: goRegular,
+			want: truetype.HoriHeader{
+				Version:             65536,
+				Ascender:            1935,
+				Descender:           -432,
+				LineGap:             0,
+				AdvanceWidthMax:     2240,
+				MinLeftSideBearing:  -440,
+				MinRightSideBearing: -441,
+				XMaxExtent:          2160,
+				CaretSlopeRise:      1,
+				CaretSlopeRun:       0,
+				CaretOffset:         0,
+				MetricDataFormat:    0,
+				NumberOfHMetrics:    665,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.HoriHeader()
+			if err != tt.wantErr {
+				t.Errorf("Face.HoriHeader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Face.HoriHeader() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestFace_VertHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		want    truetype.VertHeader
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, want: truetype.VertHeader{}, wantErr: ErrInvalidFaceHandle},
+		{name: "nimbusMono", face: nimbusMono, want: truetype.VertHeader{}, wantErr: ErrTableMissing},
+		{name: "goRegular", face: goRegular, want: truetype.VertHeader{}, wantErr: ErrTableMissing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.VertHeader()
+			if err != tt.wantErr {
+				t.Errorf("Face.VertHeader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Face.VertHeader() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestFace_OS2(t *testing.T) {
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		want    truetype.OS2
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, want: truetype.OS2{}, wantErr: ErrInvalidFaceHandle},
+		{name: "nimbusMono", face: nimbusMono, want: truetype.OS2{}, wantErr: ErrTableMissing},
+		{
+			name: "goRegular",
+			face: goRegular,
+			want: truetype.OS2{
+				Version:                 3,
+				XAvgCharWidth:           1202,
+				UsWeightClass:           400,
+				UsWidthClass:            5,
+				FsType:                  0,
+				YSubscriptXSize:         1434,
+				YSubscriptYSize:         1331,
+				YSubscriptXOffset:       0,
+				YSubscriptYOffset:       283,
+				YSuperscriptXSize:       1434,
+				YSuperscriptYSize:       1331,
+				YSuperscriptXOffset:     0,
+				YSuperscriptYOffset:     977,
+				YStrikeoutSize:          102,
+				YStrikeoutPosition:      512,
+				SFamilyClass:            2050,
+				Panose:                  [10]byte{2, 11, 6, 0, 0, 0, 0, 0, 0, 0},
+				UlUnicodeRange1:         0xa00002af,
+				UlUnicodeRange2:         0x500079fb,
+				UlUnicodeRange3:         0,
+				UlUnicodeRange4:         0,
+				AchVendID:               [4]int8{' ', ' ', ' ', ' '},
+				FsSelection:             0x40,
+				UsFirstCharIndex:        0,
+				UsLastCharIndex:         0xfffd,
+				STypoAscender:           1579,
+				STypoDescender:          -395,
+				STypoLineGap:            393,
+				UsWinAscent:             1935,
+				UsWinDescent:            432,
+				UlCodePageRange1:        0x2000009f,
+				UlCodePageRange2:        0xdfd70000,
+				SxHeight:                1086,
+				SCapHeight:              1480,
+				UsDefaultChar:           0,
+				UsBreakChar:             32,
+				UsMaxContext:            0,
+				UsLowerOpticalPointSize: 0,
+				UsUpperOpticalPointSize: 0xffff,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.OS2()
+			if err != tt.wantErr {
+				t.Errorf("Face.OS2() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Face.OS2() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestFace_Postscript(t *testing.T) {
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		want    truetype.Postscript
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, want: truetype.Postscript{}, wantErr: ErrInvalidFaceHandle},
+		{name: "nimbusMono", face: nimbusMono, want: truetype.Postscript{}, wantErr: ErrTableMissing},
+		{
+			name: "goRegular",
+			face: goRegular,
+			want: truetype.Postscript{
+				FormatType:         131072,
+				ItalicAngle:        0,
+				UnderlinePosition:  -275,
+				UnderlineThickness: 50,
+				IsFixedPitch:       0,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.Postscript()
+			if err != tt.wantErr {
+				t.Errorf("Face.Postscript() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Face.Postscript() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestFace_PCLT(t *testing.T) {
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		want    truetype.PCLT
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, want: truetype.PCLT{}, wantErr: ErrInvalidFaceHandle},
+		{name: "nimbusMono", face: nimbusMono, want: truetype.PCLT{}, wantErr: ErrTableMissing},
+		{name: "goRegular", face: goRegular, want: truetype.PCLT{}, wantErr: ErrTableMissing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.PCLT()
+			if err != tt.wantErr {
+				t.Errorf("Face.PCLT() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Face.PCLT() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestFace_MaxProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		want    truetype.MaxProfile
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, want: truetype.MaxProfile{}, wantErr: ErrInvalidFaceHandle},
+		{name: "nimbusMono", face: nimbusMono, want: truetype.MaxProfile{}, wantErr: ErrTableMissing},
+		{
+			name: "goRegular",
+			face: goRegular,
+			want: truetype.MaxProfile{
+				Version:               65536,
+				NumGlyphs:             666,
+				MaxPoints:             317,
+				MaxContours:           36,
+				MaxCompositePoints:    0,
+				MaxCompositeContours:  0,
+				MaxZones:              2,
+				MaxTwilightPoints:     216,
+				MaxStorage:            234,
+				MaxFunctionDefs:       139,
+				MaxInstructionDefs:    0,
+				MaxStackElements:      500,
+				MaxSizeOfInstructions: 3437,
+				MaxComponentElements:  0,
+				MaxComponentDepth:     0,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.MaxProfile()
+			if err != tt.wantErr {
+				t.Errorf("Face.MaxProfile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Face.MaxProfile() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestFace_LoadSfntTable(t *testing.T) {
+	type args struct {
+		tag    Tag
+		offset int
+		length int
+	}
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		args    args
+		want    []byte
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, args: args{tag: MakeTag("head")}, want: nil, wantErr: ErrInvalidFaceHandle},
+		{name: "nimbusMono", face: nimbusMono, args: args{tag: MakeTag("head")}, want: nil, wantErr: ErrTableMissing},
+		{name: "goRegular missing", face: goRegular, args: args{tag: MakeTag("GSUB")}, want: nil, wantErr: ErrTableMissing},
+		{name: "goRegular negative offset", face: goRegular, args: args{tag: MakeTag("head"), offset: -1}, want: nil, wantErr: ErrInvalidArgument},
+		{name: "goRegular negative length", face: goRegular, args: args{tag: MakeTag("head"), length: -1}, want: nil, wantErr: ErrInvalidArgument},
+		{name: "goRegular offset out of bounds", face: goRegular, args: args{tag: MakeTag("head"), offset: 55}, want: nil, wantErr: ErrInvalidArgument},
+		{name: "goRegular length out of bounds", face: goRegular, args: args{tag: MakeTag("head"), offset: 50, length: 5}, want: nil, wantErr: ErrInvalidArgument},
+		{name: "goRegular empty tail", face: goRegular, args: args{tag: MakeTag("head"), offset: 54}, want: []byte{}, wantErr: nil},
+		{name: "goRegular head", face: goRegular, args: args{tag: MakeTag("head"), length: 8}, want: []byte{0, 1, 0, 0, 0, 2, 2, 12}, wantErr: nil},
+		{name: "goRegular head magic", face: goRegular, args: args{tag: MakeTag("head"), offset: 12, length: 4}, want: []byte{0x5f, 0x0f, 0x3c, 0xf5}, wantErr: nil},
+		{name: "goRegular head tail", face: goRegular, args: args{tag: MakeTag("head"), offset: 50}, want: []byte{0, 0, 0, 0}, wantErr: nil},
+		{name: "goRegular file", face: goRegular, args: args{tag: 0, length: 4}, want: []byte{0, 1, 0, 0}, wantErr: nil},
+		{name: "goCollection file", face: goCollection(1), args: args{tag: 0, length: 4}, want: []byte("ttcf"), wantErr: nil},
+		{name: "goCollection head", face: goCollection(1), args: args{tag: MakeTag("head"), length: 8}, want: []byte{0, 1, 0, 0, 0, 2, 2, 12}, wantErr: nil},
+		{name: "chromacheckColr file", face: chromacheckColr, args: args{tag: 0, length: 4}, want: []byte{0, 1, 0, 0}, wantErr: nil},
+		{name: "chromacheckColr CPAL", face: chromacheckColr, args: args{tag: MakeTag("CPAL")}, want: []byte{0, 0, 0, 1, 0, 1, 0, 1, 0, 0, 0, 14, 0, 0, 0, 0, 0xc8, 0xff}, wantErr: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.LoadSfntTable(tt.args.tag, tt.args.offset, tt.args.length)
+			if err != tt.wantErr {
+				t.Errorf("Face.LoadSfntTable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Face.LoadSfntTable() = %v", diff)
+			}
+		})
+	}
+}
+
+func TestFace_SfntTables(t *testing.T) {
+	tests := []struct {
+		name    string
+		face    func() (testface, error)
+		want    []SfntTable
+		wantErr error
+	}{
+		{name: "nilFace", face: nilFace, want: nil, wantErr: ErrInvalidFaceHandle},
+		{name: "nimbusMono", face: nimbusMono, want: nil, wantErr: ErrTableMissing},
+		{
+			name: "goRegular",
+			face: goRegular,
+			want: []SfntTable{
+				{Tag: MakeTag("OS/2"), Offset: 236, Length: 96},
+				{Tag: MakeTag("cmap"), Offset: 332, Length: 1318},
+				{Tag: MakeTag("cvt "), Offset: 136504, Length: 176},
+				{Tag: MakeTag("fpgm"), Offset: 136680, Length: 3437},
+				{Tag: MakeTag("gasp"), Offset: 136496, Length: 8},
+				{Tag: MakeTag("glyf"), Offset: 1652, Length: 118910},
+				{Tag: MakeTag("head"), Offset: 120564, Length: 54},
+				{Tag: MakeTag("hhea"), Offset: 120620, Length: 36},
+				{Tag: MakeTag("hmtx"), Offset: 120656, Length: 2662},
+				{Tag: MakeTag("loca"), Offset: 123320, Length: 1334},
+				{Tag: MakeTag("maxp"), Offset: 124656, Length: 32},
+				{Tag: MakeTag("name"), Offset: 124688, Length: 6967},
+				{Tag: MakeTag("post"), Offset: 131656, Length: 4838},
+				{Tag: MakeTag("prep"), Offset: 140120, Length: 188},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "goCollection 0",
+			face: goCollection(0),
+			want: []SfntTable{
+				{Tag: MakeTag("OS/2"), Offset: 20 + 236, Length: 96},
+				{Tag: MakeTag("cmap"), Offset: 20 + 332, Length: 1318},
+				{Tag: MakeTag("cvt "), Offset: 20 + 136504, Length: 176},
+				{Tag: MakeTag("fpgm"), Offset: 20 + 136680, Length: 3437},
+				{Tag: MakeTag("gasp"), Offset: 20 + 136496, Length: 8},
+				{Tag: MakeTag("glyf"), Offset: 20 + 1652, Length: 118910},
+				{Tag: MakeTag("head"), Offset: 20 + 120564, Length: 54},
+				{Tag: MakeTag("hhea"), Offset: 20 + 120620, Length: 36},
+				{Tag: MakeTag("hmtx"), Offset: 20 + 120656, Length: 2662},
+				{Tag: MakeTag("loca"), Offset: 20 + 123320, Length: 1334},
+				{Tag: MakeTag("maxp"), Offset: 20 + 124656, Length: 32},
+				{Tag: MakeTag("name"), Offset: 20 + 124688, Length: 6967},
+				{Tag: MakeTag("post"), Offset: 20 + 131656, Length: 4838},
+				{Tag: MakeTag("prep"), Offset: 20 + 140120, Length: 188},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "goCollection 1",
+			face: goCollection(1),
+			want: []SfntTable{
+				{Tag: MakeTag("OS/2"), Offset: 140328 + 236, Length: 96},
+				{Tag: MakeTag("cmap"), Offset: 140328 + 332, Length: 1318},
+				{Tag: MakeTag("cvt "), Offset: 140328 + 140768, Length: 176},
+				{Tag: MakeTag("fpgm"), Offset: 140328 + 140944, Length: 3437},
+				{Tag: MakeTag("gasp"), Offset: 140328 + 140760, Length: 8},
+				{Tag: MakeTag("glyf"), Offset: 140328 + 1652, Length: 123212},
+				{Tag: MakeTag("head"), Offset: 140328 + 124864, Length: 54},
+				{Tag: MakeTag("hhea"), Offset: 140328 + 124920, Length: 36},
+				{Tag: MakeTag("hmtx"), Offset: 140328 + 124956, Length: 2662},
+				{Tag: MakeTag("loca"), Offset: 140328 + 127620, Length: 1334},
+				{Tag: MakeTag("maxp"), Offset: 140328 + 128956, Length: 32},
+				{Tag: MakeTag("name"), Offset: 140328 + 128988, Length: 6931},
+				{Tag: MakeTag("post"), Offset: 140328 + 135920, Length: 4838},
+				{Tag: MakeTag("prep"), Offset: 140328 + 144384, Length: 188},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "chromacheckColr",
+			face: chromacheckColr,
+			want: []SfntTable{
+				{Tag: MakeTag("COLR"), Offset: 608, Length: 24},
+				{Tag: MakeTag("CPAL"), Offset: 632, Length: 18},
+				{Tag: MakeTag("OS/2"), Offset: 328, Length: 96},
+				{Tag: MakeTag("cmap"), Offset: 432, Length: 44},
+				{Tag: MakeTag("glyf"), Offset: 484, Length: 26},
+				{Tag: MakeTag("head"), Offset: 204, Length: 54},
+				{Tag: MakeTag("hhea"), Offset: 260, Length: 36},
+				{Tag: MakeTag("hmtx"), Offset: 424, Length: 6},
+				{Tag: MakeTag("loca"), Offset: 476, Length: 6},
+				{Tag: MakeTag("maxp"), Offset: 296, Length: 32},
+				{Tag: MakeTag("name"), Offset: 512, Length: 62},
+				{Tag: MakeTag("post"), Offset: 576, Length: 32},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			face, err := tt.face()
+			if err != nil {
+				t.Fatalf("unable to load face: %v", err)
+			}
+			defer face.Free()
+
+			got, err := face.SfntTables()
+			if err != tt.wantErr {
+				t.Errorf("Face.SfntTables() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := diff(got, tt.want); diff != nil {
+				t.Errorf("Face.SfntTables() = %v", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,20 @@ import (
 // See https://www.freetype.org/freetype2/docs/reference/ft2-basic_types.html#ft_tag
 type Tag uint32
 
+// MakeTag converts four-letter tags into a Tag. Shorter strings are padded
+// with spaces, longer ones are truncated.
+//
+// See https://www.freetype.org/freetype2/docs/reference/ft2-basic_types.html#ft_make_tag
+func MakeTag(s string) Tag {
+	b := [4]byte{' ', ' ', ' ', ' '}
+	copy(b[:], s)
+	return Tag(b[0])<<24 | Tag(b[1])<<16 | Tag(b[2])<<8 | Tag(b[3])
+}
+
+func (t Tag) String() string {
+	return string([]byte{byte(t >> 24), byte(t >> 16), byte(t >> 8), byte(t)})
+}
+
 // Pos is used to store vectorial coordinates. Depending on the context, these can represent distances in integer
 // font units, or 16.16, or 26.6 fixed-point pixel coordinates.
 //
@@ -413,3 +413,42 @@ func TestSize_Free(t *testing.T) {
 		t.Fatalf("ptr should be nil")
 	}
 }
+
+func TestMakeTag(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want Tag
+	}{
+		{name: "empty", s: "", want: 0x20202020},
+		{name: "short", s: "cvt", want: 0x63767420},
+		{name: "exact", s: "GSUB", want: 0x47535542},
+		{name: "long", s: "GSUBX", want: 0x47535542},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeTag(tt.s); got != tt.want {
+				t.Errorf("MakeTag() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTag_String(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Tag
+		want string
+	}{
+		{name: "GSUB", t: 0x47535542, want: "GSUB"},
+		{name: "cvt ", t: 0x63767420, want: "cvt "},
+		{name: "OS/2", t: 0x4f532f32, want: "OS/2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.String(); got != tt.want {
+				t.Errorf("Tag.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}